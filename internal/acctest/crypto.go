@@ -26,6 +26,7 @@ const (
 	PEMBlockTypeECPrivateKey       = `EC PRIVATE KEY`
 	PEMBlockTypeRSAPrivateKey      = `RSA PRIVATE KEY`
 	PEMBlockTypePublicKey          = `PUBLIC KEY`
+	PEMBlockTypeX509CRL            = `X509 CRL`
 
 	bitShift128 = 128
 )
@@ -355,6 +356,61 @@ func TLSRSAX509SelfSignedCACertificateForRolesAnywhereTrustAnchorPEM(t *testing.
 	return string(pem.EncodeToMemory(certificateBlock))
 }
 
+// TLSRSAX509CRLPEM generates a x509 certificate revocation list PEM string
+// signed by the CA with the specified CRL number and one randomly revoked certificate.
+// Wrap with TLSPEMEscapeNewlines() to allow simple fmt.Sprintf()
+// configurations such as: crl_data = "%[1]s"
+func TLSRSAX509CRLPEM(t *testing.T, caKeyPem, caCertificatePem string, number int64) string {
+	t.Helper()
+
+	caCertificateBlock, _ := pem.Decode([]byte(caCertificatePem))
+
+	caCertificate, err := x509.ParseCertificate(caCertificateBlock.Bytes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	caKeyBlock, _ := pem.Decode([]byte(caKeyPem))
+
+	caKey, err := x509.ParsePKCS1PrivateKey(caKeyBlock.Bytes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, tlsX509CertificateSerialNumberLimit)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	revocationList := &x509.RevocationList{
+		NextUpdate: time.Now().Add(hoursForCertificateValidity * time.Hour),
+		Number:     big.NewInt(number),
+		RevokedCertificateEntries: []x509.RevocationListEntry{
+			{
+				RevocationTime: time.Now(),
+				SerialNumber:   serialNumber,
+			},
+		},
+		ThisUpdate: time.Now(),
+	}
+
+	revocationListBytes, err := x509.CreateRevocationList(rand.Reader, revocationList, caCertificate, caKey)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	revocationListBlock := &pem.Block{
+		Bytes: revocationListBytes,
+		Type:  PEMBlockTypeX509CRL,
+	}
+
+	return string(pem.EncodeToMemory(revocationListBlock))
+}
+
 // TLSRSAX509SelfSignedCertificatePEM generates a x509 certificate PEM string.
 // Wrap with TLSPEMEscapeNewlines() to allow simple fmt.Sprintf()
 // configurations such as: private_key_pem = "%[1]s"
@@ -29,6 +29,9 @@ func TestAccAccessAnalyzer_serial(t *testing.T) {
 			acctest.CtDisappears: testAccAnalyzerArchiveRule_disappears,
 			"update_filters":     testAccAnalyzerArchiveRule_updateFilters,
 		},
+		"UnusedAccessFindingsDataSource": {
+			acctest.CtBasic: testAccUnusedAccessFindingsDataSource_basic,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_accessanalyzer_unused_access_findings", name="Unused Access Findings")
+func dataSourceUnusedAccessFindings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceUnusedAccessFindingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"analyzer_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"finding_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.FindingType](),
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"analyzed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrCreatedAt: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finding_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_owner_account": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"min_age_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(types.FindingStatusActive),
+				ValidateDiagFunc: enum.Validate[types.FindingStatus](),
+			},
+		},
+	}
+}
+
+func dataSourceUnusedAccessFindingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	analyzerARN := d.Get("analyzer_arn").(string)
+	input := &accessanalyzer.ListFindingsV2Input{
+		AnalyzerArn: aws.String(analyzerARN),
+		Filter: map[string]types.Criterion{
+			names.AttrStatus: {
+				Eq: []string{d.Get(names.AttrStatus).(string)},
+			},
+		},
+	}
+
+	if v, ok := d.GetOk("finding_type"); ok {
+		input.Filter["findingType"] = types.Criterion{
+			Eq: []string{v.(string)},
+		}
+	}
+
+	findings, err := findFindingsV2(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Access Analyzer Findings (%s): %s", analyzerARN, err)
+	}
+
+	// The ListFindingsV2 API has no date-based filter criteria, so findings are filtered by age here.
+	if v, ok := d.GetOk("min_age_in_days"); ok {
+		createdBefore := time.Now().AddDate(0, 0, -v.(int))
+		var filtered []types.FindingSummaryV2
+
+		for _, finding := range findings {
+			if aws.ToTime(finding.CreatedAt).Before(createdBefore) {
+				filtered = append(filtered, finding)
+			}
+		}
+
+		findings = filtered
+	}
+
+	d.SetId(analyzerARN)
+	if err := d.Set("findings", flattenFindingSummaryV2s(findings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting findings: %s", err)
+	}
+
+	return diags
+}
+
+func findFindingsV2(ctx context.Context, conn *accessanalyzer.Client, input *accessanalyzer.ListFindingsV2Input) ([]types.FindingSummaryV2, error) {
+	var output []types.FindingSummaryV2
+
+	pages := accessanalyzer.NewListFindingsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Findings...)
+	}
+
+	return output, nil
+}
+
+func flattenFindingSummaryV2s(apiObjects []types.FindingSummaryV2) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"analyzed_at":            aws.ToTime(apiObject.AnalyzedAt).Format(time.RFC3339),
+			names.AttrCreatedAt:      aws.ToTime(apiObject.CreatedAt).Format(time.RFC3339),
+			"finding_type":           string(apiObject.FindingType),
+			names.AttrID:             aws.ToString(apiObject.Id),
+			names.AttrResourceARN:    aws.ToString(apiObject.Resource),
+			"resource_owner_account": aws.ToString(apiObject.ResourceOwnerAccount),
+			names.AttrResourceType:   string(apiObject.ResourceType),
+			names.AttrStatus:         string(apiObject.Status),
+			"updated_at":             aws.ToTime(apiObject.UpdatedAt).Format(time.RFC3339),
+		})
+	}
+
+	return tfList
+}
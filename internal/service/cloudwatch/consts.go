@@ -30,3 +30,15 @@ func missingData_Values() []string {
 		missingDataNotBreaching,
 	}
 }
+
+const (
+	insightRuleStateDisabled = "DISABLED"
+	insightRuleStateEnabled  = "ENABLED"
+)
+
+func insightRuleState_Values() []string {
+	return []string{
+		insightRuleStateDisabled,
+		insightRuleStateEnabled,
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudwatch_contributor_insight_rule", name="Contributor Insight Rule")
+// @Tags(identifierAttribute="arn")
+func resourceContributorInsightRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceContributorInsightRuleCreate,
+		ReadWithoutTimeout:   resourceContributorInsightRuleRead,
+		UpdateWithoutTimeout: resourceContributorInsightRuleUpdate,
+		DeleteWithoutTimeout: resourceContributorInsightRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule_definition": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validContributorInsightRuleDefinition,
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"rule_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validContributorInsightRuleName,
+			},
+			"rule_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      insightRuleStateEnabled,
+				ValidateFunc: validation.StringInSlice(insightRuleState_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceContributorInsightRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	name := d.Get("rule_name").(string)
+	input := &cloudwatch.PutInsightRuleInput{
+		RuleDefinition: aws.String(d.Get("rule_definition").(string)),
+		RuleName:       aws.String(name),
+		RuleState:      aws.String(d.Get("rule_state").(string)),
+		Tags:           getTagsIn(ctx),
+	}
+
+	_, err := conn.PutInsightRule(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudWatch Contributor Insight Rule (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceContributorInsightRuleRead(ctx, d, meta)...)
+}
+
+func resourceContributorInsightRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	rule, err := findContributorInsightRuleByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Contributor Insight Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Contributor Insight Rule (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, insightRuleARN(meta.(*conns.AWSClient), aws.ToString(rule.Name)))
+	d.Set("rule_definition", rule.Definition)
+	d.Set("rule_name", rule.Name)
+	d.Set("rule_state", rule.State)
+
+	return diags
+}
+
+func resourceContributorInsightRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	if d.HasChanges("rule_definition", "rule_state") {
+		input := &cloudwatch.PutInsightRuleInput{
+			RuleDefinition: aws.String(d.Get("rule_definition").(string)),
+			RuleName:       aws.String(d.Id()),
+			RuleState:      aws.String(d.Get("rule_state").(string)),
+		}
+
+		_, err := conn.PutInsightRule(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudWatch Contributor Insight Rule (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceContributorInsightRuleRead(ctx, d, meta)...)
+}
+
+func resourceContributorInsightRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	if err := deleteInsightRule(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudWatch Contributor Insight Rule (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func deleteInsightRule(ctx context.Context, conn *cloudwatch.Client, name string) error {
+	log.Printf("[DEBUG] Deleting CloudWatch Insight Rule: %s", name)
+	output, err := conn.DeleteInsightRules(ctx, &cloudwatch.DeleteInsightRulesInput{
+		RuleNames: []string{name},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range output.Failures {
+		if aws.ToString(v.FailureCode) == errCodeResourceNotFound {
+			continue
+		}
+
+		return partialFailureError(v)
+	}
+
+	return nil
+}
+
+func findContributorInsightRuleByName(ctx context.Context, conn *cloudwatch.Client, name string) (*types.InsightRule, error) {
+	input := &cloudwatch.DescribeInsightRulesInput{}
+
+	return findInsightRule(ctx, conn, input, func(v *types.InsightRule) bool {
+		return aws.ToString(v.Name) == name
+	})
+}
+
+func findInsightRule(ctx context.Context, conn *cloudwatch.Client, input *cloudwatch.DescribeInsightRulesInput, filter tfslices.Predicate[*types.InsightRule]) (*types.InsightRule, error) {
+	output, err := findInsightRules(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findInsightRules(ctx context.Context, conn *cloudwatch.Client, input *cloudwatch.DescribeInsightRulesInput, filter tfslices.Predicate[*types.InsightRule]) ([]types.InsightRule, error) {
+	var output []types.InsightRule
+
+	pages := cloudwatch.NewDescribeInsightRulesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.InsightRules {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func insightRuleARN(c *conns.AWSClient, name string) string {
+	return arn.ARN{
+		Partition: c.Partition,
+		Service:   "cloudwatch",
+		Region:    c.Region,
+		AccountID: c.AccountID,
+		Resource:  "insight-rule/" + name,
+	}.String()
+}
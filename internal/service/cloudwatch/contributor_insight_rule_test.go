@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudwatch "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudWatchContributorInsightRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rule types.InsightRule
+	resourceName := "aws_cloudwatch_contributor_insight_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContributorInsightRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContributorInsightRuleConfig_basic(rName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightRuleExists(ctx, resourceName, &rule),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "cloudwatch", fmt.Sprintf("insight-rule/%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "rule_definition"),
+					resource.TestCheckResourceAttr(resourceName, "rule_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule_state", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContributorInsightRuleConfig_basic(rName, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "rule_state", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchContributorInsightRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rule types.InsightRule
+	resourceName := "aws_cloudwatch_contributor_insight_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContributorInsightRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContributorInsightRuleConfig_basic(rName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightRuleExists(ctx, resourceName, &rule),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcloudwatch.ResourceContributorInsightRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchContributorInsightRule_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rule types.InsightRule
+	resourceName := "aws_cloudwatch_contributor_insight_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContributorInsightRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContributorInsightRuleConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContributorInsightRuleConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccContributorInsightRuleConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContributorInsightRuleExists(ctx context.Context, n string, v *types.InsightRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchClient(ctx)
+
+		output, err := tfcloudwatch.FindContributorInsightRuleByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckContributorInsightRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudwatch_contributor_insight_rule" {
+				continue
+			}
+
+			_, err := tfcloudwatch.FindContributorInsightRuleByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudWatch Contributor Insight Rule %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccContributorInsightRuleConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccContributorInsightRuleConfig_basic(rName, state string) string {
+	return acctest.ConfigCompose(testAccContributorInsightRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_contributor_insight_rule" "test" {
+  rule_name  = %[1]q
+  rule_state = %[2]q
+
+  rule_definition = jsonencode({
+    Schema = {
+      Name    = "CloudWatchLogRule"
+      Version = 1
+    }
+    AggregateOn   = "Count"
+    LogFormat     = "JSON"
+    LogGroupNames = [aws_cloudwatch_log_group.test.name]
+    Contribution = {
+      Keys = ["$.ip"]
+      Filters = [{
+        Match = "$.httpMethod"
+        In    = ["PUT"]
+      }]
+    }
+  })
+}
+`, rName, state))
+}
+
+func testAccContributorInsightRuleConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccContributorInsightRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_contributor_insight_rule" "test" {
+  rule_name = %[1]q
+
+  rule_definition = jsonencode({
+    Schema = {
+      Name    = "CloudWatchLogRule"
+      Version = 1
+    }
+    AggregateOn   = "Count"
+    LogFormat     = "JSON"
+    LogGroupNames = [aws_cloudwatch_log_group.test.name]
+    Contribution = {
+      Keys = ["$.ip"]
+    }
+  })
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccContributorInsightRuleConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccContributorInsightRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_contributor_insight_rule" "test" {
+  rule_name = %[1]q
+
+  rule_definition = jsonencode({
+    Schema = {
+      Name    = "CloudWatchLogRule"
+      Version = 1
+    }
+    AggregateOn   = "Count"
+    LogFormat     = "JSON"
+    LogGroupNames = [aws_cloudwatch_log_group.test.name]
+    Contribution = {
+      Keys = ["$.ip"]
+    }
+  })
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
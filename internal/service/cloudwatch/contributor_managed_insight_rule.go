@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudwatch_contributor_managed_insight_rule", name="Contributor Managed Insight Rule")
+// @Tags(identifierAttribute="arn")
+func resourceContributorManagedInsightRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceContributorManagedInsightRuleCreate,
+		ReadWithoutTimeout:   resourceContributorManagedInsightRuleRead,
+		UpdateWithoutTimeout: resourceContributorManagedInsightRuleUpdate,
+		DeleteWithoutTimeout: resourceContributorManagedInsightRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrResourceARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"rule_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrState: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      insightRuleStateEnabled,
+				ValidateFunc: validation.StringInSlice(insightRuleState_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"template_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+		},
+	}
+}
+
+const (
+	contributorManagedInsightRuleResourceIDPartCount = 2
+)
+
+func resourceContributorManagedInsightRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	resourceARN, templateName := d.Get(names.AttrResourceARN).(string), d.Get("template_name").(string)
+	id, err := flex.FlattenResourceId([]string{resourceARN, templateName}, contributorManagedInsightRuleResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &cloudwatch.PutManagedInsightRulesInput{
+		ManagedRules: []types.ManagedRule{{
+			ResourceARN:  aws.String(resourceARN),
+			Tags:         getTagsIn(ctx),
+			TemplateName: aws.String(templateName),
+		}},
+	}
+
+	output, err := conn.PutManagedInsightRules(ctx, input)
+
+	if err == nil && len(output.Failures) > 0 {
+		err = partialFailureError(output.Failures[0])
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudWatch Contributor Managed Insight Rule (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	// Managed rules are always created enabled.
+	if d.Get(names.AttrState).(string) == insightRuleStateDisabled {
+		rule, err := findContributorManagedInsightRuleByTwoPartKey(ctx, conn, resourceARN, templateName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading CloudWatch Contributor Managed Insight Rule (%s): %s", d.Id(), err)
+		}
+
+		if err := updateInsightRuleState(ctx, conn, aws.ToString(rule.RuleState.RuleName), insightRuleStateDisabled); err != nil {
+			return sdkdiag.AppendErrorf(diags, "disabling CloudWatch Contributor Managed Insight Rule (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceContributorManagedInsightRuleRead(ctx, d, meta)...)
+}
+
+func resourceContributorManagedInsightRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), contributorManagedInsightRuleResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	rule, err := findContributorManagedInsightRuleByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Contributor Managed Insight Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Contributor Managed Insight Rule (%s): %s", d.Id(), err)
+	}
+
+	ruleName := aws.ToString(rule.RuleState.RuleName)
+	d.Set(names.AttrARN, insightRuleARN(meta.(*conns.AWSClient), ruleName))
+	d.Set(names.AttrResourceARN, rule.ResourceARN)
+	d.Set("rule_name", ruleName)
+	d.Set(names.AttrState, rule.RuleState.State)
+	d.Set("template_name", rule.TemplateName)
+
+	return diags
+}
+
+func resourceContributorManagedInsightRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	if d.HasChange(names.AttrState) {
+		if err := updateInsightRuleState(ctx, conn, d.Get("rule_name").(string), d.Get(names.AttrState).(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudWatch Contributor Managed Insight Rule (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceContributorManagedInsightRuleRead(ctx, d, meta)...)
+}
+
+func resourceContributorManagedInsightRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	if err := deleteInsightRule(ctx, conn, d.Get("rule_name").(string)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudWatch Contributor Managed Insight Rule (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func updateInsightRuleState(ctx context.Context, conn *cloudwatch.Client, name, state string) error {
+	var failures []types.PartialFailure
+
+	switch state {
+	case insightRuleStateEnabled:
+		output, err := conn.EnableInsightRules(ctx, &cloudwatch.EnableInsightRulesInput{
+			RuleNames: []string{name},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		failures = output.Failures
+	case insightRuleStateDisabled:
+		output, err := conn.DisableInsightRules(ctx, &cloudwatch.DisableInsightRulesInput{
+			RuleNames: []string{name},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		failures = output.Failures
+	}
+
+	if len(failures) > 0 {
+		return partialFailureError(failures[0])
+	}
+
+	return nil
+}
+
+func findContributorManagedInsightRuleByTwoPartKey(ctx context.Context, conn *cloudwatch.Client, resourceARN, templateName string) (*types.ManagedRuleDescription, error) {
+	input := &cloudwatch.ListManagedInsightRulesInput{
+		ResourceARN: aws.String(resourceARN),
+	}
+
+	return findManagedInsightRule(ctx, conn, input, func(v *types.ManagedRuleDescription) bool {
+		// Rules that are not enabled for the resource have no state.
+		return aws.ToString(v.TemplateName) == templateName && v.RuleState != nil
+	})
+}
+
+func findManagedInsightRule(ctx context.Context, conn *cloudwatch.Client, input *cloudwatch.ListManagedInsightRulesInput, filter tfslices.Predicate[*types.ManagedRuleDescription]) (*types.ManagedRuleDescription, error) {
+	output, err := findManagedInsightRules(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findManagedInsightRules(ctx context.Context, conn *cloudwatch.Client, input *cloudwatch.ListManagedInsightRulesInput, filter tfslices.Predicate[*types.ManagedRuleDescription]) ([]types.ManagedRuleDescription, error) {
+	var output []types.ManagedRuleDescription
+
+	pages := cloudwatch.NewListManagedInsightRulesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ManagedRules {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
@@ -4,9 +4,16 @@
 package cloudwatch
 
 import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 )
 
 var (
 	errCodeResourceNotFound = (*types.ResourceNotFound)(nil).ErrorCode()
 )
+
+func partialFailureError(apiObject types.PartialFailure) error {
+	return fmt.Errorf("%s: %s", aws.ToString(apiObject.FailureCode), aws.ToString(apiObject.FailureDescription))
+}
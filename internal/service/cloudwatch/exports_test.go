@@ -5,13 +5,17 @@ package cloudwatch
 
 // Exports for use in tests only.
 var (
-	ResourceCompositeAlarm = resourceCompositeAlarm
-	ResourceDashboard      = resourceDashboard
-	ResourceMetricAlarm    = resourceMetricAlarm
-	ResourceMetricStream   = resourceMetricStream
+	ResourceCompositeAlarm                = resourceCompositeAlarm
+	ResourceContributorInsightRule        = resourceContributorInsightRule
+	ResourceContributorManagedInsightRule = resourceContributorManagedInsightRule
+	ResourceDashboard                     = resourceDashboard
+	ResourceMetricAlarm                   = resourceMetricAlarm
+	ResourceMetricStream                  = resourceMetricStream
 
-	FindCompositeAlarmByName = findCompositeAlarmByName
-	FindDashboardByName      = findDashboardByName
-	FindMetricAlarmByName    = findMetricAlarmByName
-	FindMetricStreamByName   = findMetricStreamByName
+	FindCompositeAlarmByName                      = findCompositeAlarmByName
+	FindContributorInsightRuleByName              = findContributorInsightRuleByName
+	FindContributorManagedInsightRuleByTwoPartKey = findContributorManagedInsightRuleByTwoPartKey
+	FindDashboardByName                           = findDashboardByName
+	FindMetricAlarmByName                         = findMetricAlarmByName
+	FindMetricStreamByName                        = findMetricStreamByName
 )
@@ -144,9 +144,12 @@ func resourceMetricAlarm() *schema.Resource {
 							ValidateFunc: validation.StringLenBetween(1, 255),
 						},
 						names.AttrExpression: {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringLenBetween(1, 1024),
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 1024),
+								validMetricQueryExpression,
+							),
 						},
 						names.AttrID: {
 							Type:         schema.TypeString,
@@ -309,6 +312,12 @@ func resourceMetricAlarm() *schema.Resource {
 									return errors.New("No metric_query may have both `expression` and a `metric` specified")
 								}
 							}
+
+							if isMetricsInsightsQuery(v.(string)) {
+								if v, ok := tfMap["period"].(int); !ok || v == 0 {
+									return errors.New("A metric_query with a Metrics Insights query `expression` must have a `period` specified")
+								}
+							}
 						}
 					}
 				}
@@ -563,6 +563,36 @@ func TestAccCloudWatchMetricAlarm_metricQuery(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchMetricAlarm_metricQueryInsightsValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricAlarmDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMetricAlarmConfig_metricQueryExpressionInsights(rName, "SELECT MAX(CPUUtilization) WHERE InstanceId = 'i-abcd1234'", 60),
+				ExpectError: regexache.MustCompile(`is not a valid Metrics Insights query`),
+			},
+			{
+				Config:      testAccMetricAlarmConfig_metricQueryExpressionInsights(rName, "SELECT MAX(CPUUtilization) FROM \"AWS/EC2\" LIMIT 1000", 60),
+				ExpectError: regexache.MustCompile(`Metrics Insights query LIMIT must be between 1 and 500`),
+			},
+			{
+				Config:      testAccMetricAlarmConfig_metricQueryExpressionInsights(rName, "SELECT MAX(CPUUtilization) FROM \"AWS/EC2\"", 0),
+				ExpectError: regexache.MustCompile("A metric_query with a Metrics Insights query `expression` must have a `period` specified"),
+			},
+			{
+				Config:      testAccMetricAlarmConfig_metricQueryExpressionInsights(rName, "MAX(METRICS(m1))", 60),
+				ExpectError: regexache.MustCompile(`METRICS\(\) must have no argument or a single double-quoted string argument`),
+			},
+		},
+	})
+}
+
 func TestAccCloudWatchMetricAlarm_missingStatistic(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1044,6 +1074,24 @@ resource "aws_cloudwatch_metric_alarm" "test" {
 `, rName)
 }
 
+func testAccMetricAlarmConfig_metricQueryExpressionInsights(rName, expression string, period int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 3
+  threshold           = 80
+
+  metric_query {
+    id          = "q1"
+    expression  = %[2]q
+    period      = %[3]d
+    return_data = true
+  }
+}
+`, rName, expression, period)
+}
+
 // EC2 Automate requires a valid EC2 instance
 // ValidationError: Invalid use of EC2 'Recover' action. i-abcd1234 is not a valid EC2 instance.
 func testAccMetricAlarmConfig_actionsEC2Automate(rName, action string) string {
@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cloudwatch_metric_data", name="Metric Data")
+func dataSourceMetricData() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMetricDataRead,
+
+		Schema: map[string]*schema.Schema{
+			"end_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"max_datapoints": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"metric_data_results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatusCode: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timestamps": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrValues: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeFloat},
+						},
+					},
+				},
+			},
+			"metric_query": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 500,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAccountID: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						names.AttrExpression: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 255),
+								validation.StringMatch(regexache.MustCompile(`^[a-z][0-9A-Za-z_]*$`), "must start with a lowercase letter and contain only letters, numbers and underscores"),
+							),
+						},
+						"metric": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimensions": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									names.AttrMetricName: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									names.AttrNamespace: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"period": {
+										Type:     schema.TypeInt,
+										Required: true,
+										ValidateFunc: validation.Any(
+											validation.IntInSlice([]int{1, 5, 10, 30}),
+											validation.IntDivisibleBy(60),
+										),
+									},
+									"stat": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									names.AttrUnit: {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[types.StandardUnit](),
+									},
+								},
+							},
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"period": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ValidateFunc: validation.Any(
+								validation.IntInSlice([]int{1, 5, 10, 30}),
+								validation.IntDivisibleBy(60),
+							),
+						},
+						"return_data": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"scan_by": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(types.ScanByTimestampDescending),
+				ValidateDiagFunc: enum.Validate[types.ScanBy](),
+			},
+			names.AttrStartTime: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+		},
+	}
+}
+
+func dataSourceMetricDataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	startTime, _ := time.Parse(time.RFC3339, d.Get(names.AttrStartTime).(string))
+	endTime, _ := time.Parse(time.RFC3339, d.Get("end_time").(string))
+	input := &cloudwatch.GetMetricDataInput{
+		EndTime:           aws.Time(endTime),
+		MetricDataQueries: expandMetricAlarmMetrics(d.Get("metric_query").([]interface{})),
+		ScanBy:            types.ScanBy(d.Get("scan_by").(string)),
+		StartTime:         aws.Time(startTime),
+	}
+
+	if v, ok := d.GetOk("max_datapoints"); ok {
+		input.MaxDatapoints = aws.Int32(int32(v.(int)))
+	}
+
+	output, err := findMetricData(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Metric Data: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("metric_data_results", flattenMetricDataResults(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting metric_data_results: %s", err)
+	}
+
+	return diags
+}
+
+// findMetricData returns the results of all pages, combining the data points of each query's results.
+func findMetricData(ctx context.Context, conn *cloudwatch.Client, input *cloudwatch.GetMetricDataInput) ([]types.MetricDataResult, error) {
+	var output []types.MetricDataResult
+	indexByID := make(map[string]int)
+
+	pages := cloudwatch.NewGetMetricDataPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.MetricDataResults {
+			id := aws.ToString(v.Id)
+
+			if i, ok := indexByID[id]; ok {
+				output[i].Timestamps = append(output[i].Timestamps, v.Timestamps...)
+				output[i].Values = append(output[i].Values, v.Values...)
+				output[i].StatusCode = v.StatusCode
+				continue
+			}
+
+			indexByID[id] = len(output)
+			output = append(output, v)
+		}
+	}
+
+	return output, nil
+}
+
+func flattenMetricDataResults(apiObjects []types.MetricDataResult) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		timestamps := make([]interface{}, 0, len(apiObject.Timestamps))
+		for _, v := range apiObject.Timestamps {
+			timestamps = append(timestamps, v.Format(time.RFC3339))
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrID:         aws.ToString(apiObject.Id),
+			"label":              aws.ToString(apiObject.Label),
+			names.AttrStatusCode: string(apiObject.StatusCode),
+			"timestamps":         timestamps,
+			names.AttrValues:     apiObject.Values,
+		})
+	}
+
+	return tfList
+}
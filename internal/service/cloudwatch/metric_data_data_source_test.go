@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudWatchMetricDataDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_metric_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricDataDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metric_data_results.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_data_results.0.id", "calls_per_minute"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_data_results.0.label", "API calls per minute"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_data_results.0.status_code", "Complete"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "metric_data_results.0.timestamps.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "metric_data_results.0.values.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccMetricDataDataSourceConfig_basic = `
+data "aws_cloudwatch_metric_data" "test" {
+  start_time = timeadd(plantimestamp(), "-1h")
+  end_time   = plantimestamp()
+
+  metric_query {
+    id          = "calls"
+    return_data = false
+
+    metric {
+      namespace   = "AWS/Usage"
+      metric_name = "CallCount"
+      period      = 300
+      stat        = "Sum"
+
+      dimensions = {
+        Type     = "API"
+        Resource = "GetMetricData"
+        Service  = "CloudWatch"
+        Class    = "None"
+      }
+    }
+  }
+
+  metric_query {
+    id         = "calls_per_minute"
+    expression = "calls / 5"
+    label      = "API calls per minute"
+  }
+}
+`
@@ -4,7 +4,11 @@
 package cloudwatch
 
 import (
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/YakDriver/regexache"
 )
@@ -40,3 +44,165 @@ func validEC2AutomateARN(v interface{}, k string) (ws []string, errors []error)
 
 	return
 }
+
+func validContributorInsightRuleName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	// https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/API_PutInsightRule.html
+	pattern := `^[\x20-\x7E]{1,128}$`
+	if !regexache.MustCompile(pattern).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q doesn't comply with restrictions (%q): %q",
+			k, pattern, value))
+	}
+
+	return
+}
+
+// contributorInsightRuleDefinition is the Contributor Insights rule syntax for log groups.
+// https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/ContributorInsights-RuleSyntax.html
+type contributorInsightRuleDefinition struct {
+	AggregateOn  string `json:"AggregateOn"`
+	Contribution *struct {
+		Filters []map[string]json.RawMessage `json:"Filters"`
+		Keys    []string                     `json:"Keys"`
+		ValueOf string                       `json:"ValueOf"`
+	} `json:"Contribution"`
+	Fields        map[string]string `json:"Fields"`
+	LogFormat     string            `json:"LogFormat"`
+	LogGroupARNs  []string          `json:"LogGroupARNs"`
+	LogGroupNames []string          `json:"LogGroupNames"`
+	Schema        *struct {
+		Name    string `json:"Name"`
+		Version int    `json:"Version"`
+	} `json:"Schema"`
+}
+
+var contributorInsightRuleFilterOperators = []string{
+	"EqualTo",
+	"GreaterThan",
+	"In",
+	"IsPresent",
+	"LessThan",
+	"NotEqualTo",
+	"NotIn",
+	"StartsWith",
+}
+
+func validContributorInsightRuleDefinition(v interface{}, k string) (ws []string, errors []error) {
+	var definition contributorInsightRuleDefinition
+
+	if err := json.Unmarshal([]byte(v.(string)), &definition); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+		return
+	}
+
+	if definition.Schema == nil || definition.Schema.Name != "CloudWatchLogRule" || definition.Schema.Version != 1 {
+		errors = append(errors, fmt.Errorf(`%q must have a Schema with Name "CloudWatchLogRule" and Version 1`, k))
+	}
+
+	if len(definition.LogGroupNames) == 0 && len(definition.LogGroupARNs) == 0 {
+		errors = append(errors, fmt.Errorf("%q must specify LogGroupNames or LogGroupARNs", k))
+	}
+
+	switch definition.LogFormat {
+	case "JSON":
+		if len(definition.Fields) > 0 {
+			errors = append(errors, fmt.Errorf("%q may only specify Fields when LogFormat is CLF", k))
+		}
+	case "CLF":
+	default:
+		errors = append(errors, fmt.Errorf(`%q must have a LogFormat of "JSON" or "CLF", got: %q`, k, definition.LogFormat))
+	}
+
+	switch definition.AggregateOn {
+	case "Count":
+	case "Sum":
+		if definition.Contribution != nil && definition.Contribution.ValueOf == "" {
+			errors = append(errors, fmt.Errorf(`%q must specify Contribution.ValueOf when AggregateOn is "Sum"`, k))
+		}
+	default:
+		errors = append(errors, fmt.Errorf(`%q must have an AggregateOn of "Count" or "Sum", got: %q`, k, definition.AggregateOn))
+	}
+
+	if definition.Contribution == nil {
+		errors = append(errors, fmt.Errorf("%q must specify Contribution", k))
+		return
+	}
+
+	if n := len(definition.Contribution.Keys); n < 1 || n > 4 {
+		errors = append(errors, fmt.Errorf("%q must specify between 1 and 4 Contribution.Keys, got: %d", k, n))
+	}
+
+	if n := len(definition.Contribution.Filters); n > 4 {
+		errors = append(errors, fmt.Errorf("%q must specify at most 4 Contribution.Filters, got: %d", k, n))
+	}
+
+	for i, filter := range definition.Contribution.Filters {
+		if _, ok := filter["Match"]; !ok {
+			errors = append(errors, fmt.Errorf("%q Contribution.Filters[%d] must specify Match", k, i))
+		}
+
+		var operators int
+		for key := range filter {
+			if key == "Match" {
+				continue
+			}
+
+			if !slices.Contains(contributorInsightRuleFilterOperators, key) {
+				errors = append(errors, fmt.Errorf("%q Contribution.Filters[%d] has an unsupported operator: %q", k, i, key))
+				continue
+			}
+
+			operators++
+		}
+
+		if operators != 1 {
+			errors = append(errors, fmt.Errorf("%q Contribution.Filters[%d] must specify exactly one operator", k, i))
+		}
+	}
+
+	return
+}
+
+const (
+	metricsInsightsQueryMaxLimit = 500
+)
+
+// validMetricQueryExpression validates a metric math expression or a Metrics Insights query.
+// https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/query_with_cloudwatch-metrics-insights.html
+func validMetricQueryExpression(v interface{}, k string) (ws []string, errors []error) {
+	value := strings.TrimSpace(v.(string))
+
+	if !isMetricsInsightsQuery(value) {
+		// METRICS() takes no argument or a single double-quoted string used to filter metrics by ID.
+		if n, m := strings.Count(value, "METRICS("), len(regexache.MustCompile(`METRICS\(\s*("[^"]*")?\s*\)`).FindAllString(value, -1)); n != m {
+			errors = append(errors, fmt.Errorf(`%q METRICS() must have no argument or a single double-quoted string argument: %q`, k, value))
+		}
+
+		return
+	}
+
+	// Only the clauses needed to check LIMIT are matched; CloudWatch validates the rest of the query.
+	pattern := `(?is)^SELECT\s+.+?\s+FROM\s+.+?(\s+LIMIT\s+(?P<limit>\d+))?$`
+	re := regexache.MustCompile(pattern)
+	matches := re.FindStringSubmatch(value)
+
+	if matches == nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Metrics Insights query (SELECT ... FROM ...): %q", k, value))
+		return
+	}
+
+	if v := matches[re.SubexpIndex("limit")]; v != "" {
+		if limit, err := strconv.Atoi(v); err != nil || limit < 1 || limit > metricsInsightsQueryMaxLimit {
+			errors = append(errors, fmt.Errorf("%q Metrics Insights query LIMIT must be between 1 and %d: %q", k, metricsInsightsQueryMaxLimit, value))
+		}
+	}
+
+	return
+}
+
+// isMetricsInsightsQuery returns whether a metric query expression is a Metrics Insights query rather than a metric math expression.
+func isMetricsInsightsQuery(expression string) bool {
+	return regexache.MustCompile(`(?i)^\s*SELECT\s`).MatchString(expression)
+}
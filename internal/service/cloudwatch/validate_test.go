@@ -74,3 +74,98 @@ func TestValidEC2AutomateARN(t *testing.T) {
 		}
 	}
 }
+
+func TestValidContributorInsightRuleDefinition(t *testing.T) {
+	t.Parallel()
+
+	validDefinitions := []string{
+		`{
+  "Schema": {"Name": "CloudWatchLogRule", "Version": 1},
+  "LogGroupNames": ["API-Gateway-Access-Logs*"],
+  "LogFormat": "JSON",
+  "Contribution": {
+    "Keys": ["$.ip"],
+    "Filters": [{"Match": "$.httpMethod", "In": ["PUT"]}]
+  },
+  "AggregateOn": "Count"
+}`,
+		`{
+  "Schema": {"Name": "CloudWatchLogRule", "Version": 1},
+  "LogGroupNames": ["/aws/vpc/flowlogs"],
+  "LogFormat": "CLF",
+  "Fields": {"4": "srcaddr", "10": "bytes"},
+  "Contribution": {
+    "Keys": ["srcaddr"],
+    "ValueOf": "bytes"
+  },
+  "AggregateOn": "Sum"
+}`,
+	}
+	for _, v := range validDefinitions {
+		_, errors := validContributorInsightRuleDefinition(v, "rule_definition")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Contributor Insights rule definition: %q", v, errors)
+		}
+	}
+
+	invalidDefinitions := []string{
+		`not JSON`,
+		// Wrong schema.
+		`{"Schema": {"Name": "Other", "Version": 1}, "LogGroupNames": ["lg"], "LogFormat": "JSON", "Contribution": {"Keys": ["$.ip"]}, "AggregateOn": "Count"}`,
+		// No log groups.
+		`{"Schema": {"Name": "CloudWatchLogRule", "Version": 1}, "LogFormat": "JSON", "Contribution": {"Keys": ["$.ip"]}, "AggregateOn": "Count"}`,
+		// Fields with JSON logs.
+		`{"Schema": {"Name": "CloudWatchLogRule", "Version": 1}, "LogGroupNames": ["lg"], "LogFormat": "JSON", "Fields": {"1": "ip"}, "Contribution": {"Keys": ["$.ip"]}, "AggregateOn": "Count"}`,
+		// Sum without ValueOf.
+		`{"Schema": {"Name": "CloudWatchLogRule", "Version": 1}, "LogGroupNames": ["lg"], "LogFormat": "JSON", "Contribution": {"Keys": ["$.ip"]}, "AggregateOn": "Sum"}`,
+		// Too many keys.
+		`{"Schema": {"Name": "CloudWatchLogRule", "Version": 1}, "LogGroupNames": ["lg"], "LogFormat": "JSON", "Contribution": {"Keys": ["$.a", "$.b", "$.c", "$.d", "$.e"]}, "AggregateOn": "Count"}`,
+		// Filter with two operators.
+		`{"Schema": {"Name": "CloudWatchLogRule", "Version": 1}, "LogGroupNames": ["lg"], "LogFormat": "JSON", "Contribution": {"Keys": ["$.ip"], "Filters": [{"Match": "$.a", "In": ["x"], "NotIn": ["y"]}]}, "AggregateOn": "Count"}`,
+		// Filter with an unknown operator.
+		`{"Schema": {"Name": "CloudWatchLogRule", "Version": 1}, "LogGroupNames": ["lg"], "LogFormat": "JSON", "Contribution": {"Keys": ["$.ip"], "Filters": [{"Match": "$.a", "Contains": "x"}]}, "AggregateOn": "Count"}`,
+	}
+	for _, v := range invalidDefinitions {
+		_, errors := validContributorInsightRuleDefinition(v, "rule_definition")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Contributor Insights rule definition", v)
+		}
+	}
+}
+
+func TestValidMetricQueryExpression(t *testing.T) {
+	t.Parallel()
+
+	validExpressions := []string{
+		"m1",
+		"m1 + m2",
+		"ANOMALY_DETECTION_BAND(m1)",
+		"SUM(METRICS())",
+		`AVG(METRICS("errors"))`,
+		`SELECT MAX(MillisBehindLatest) FROM SCHEMA("foo", Operation, ShardId) WHERE Operation = 'ProcessTask'`,
+		`SELECT AVG(CPUUtilization) FROM "AWS/EC2"`,
+		`SELECT SUM(RequestCount) FROM SCHEMA("AWS/ApplicationELB", LoadBalancer) GROUP BY LoadBalancer ORDER BY SUM() DESC LIMIT 10`,
+		`select count(Invocations) from "AWS/Lambda" where FunctionName != 'test' limit 500`,
+		`SELECT AVG("My Metric") FROM "Custom/App" WHERE "My Dimension" = 'a b'`,
+	}
+	for _, v := range validExpressions {
+		_, errors := validMetricQueryExpression(v, names.AttrExpression)
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid metric query expression: %q", v, errors)
+		}
+	}
+
+	invalidExpressions := []string{
+		"MAX(METRICS(m1))",
+		"SUM(METRICS('errors'))",
+		`SELECT MAX(CPUUtilization) WHERE InstanceId = 'i-abcd1234'`,
+		`SELECT AVG(CPUUtilization) FROM "AWS/EC2" LIMIT 501`,
+		`SELECT AVG(CPUUtilization) FROM "AWS/EC2" LIMIT 0`,
+	}
+	for _, v := range invalidExpressions {
+		_, errors := validMetricQueryExpression(v, names.AttrExpression)
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid metric query expression", v)
+		}
+	}
+}
@@ -6,6 +6,7 @@ package dynamodb_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/YakDriver/regexache"
@@ -108,6 +109,48 @@ func TestAccDynamoDBResourcePolicy_disappears(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBResourcePolicy_invalidPrincipal(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourcePolicyConfig_principal(`{ AWS = "not-an-account" }`, ""),
+				ExpectError: regexache.MustCompile(`Principal.AWS must be "\*", an AWS account ID or an IAM ARN`),
+			},
+			{
+				Config:      testAccResourcePolicyConfig_principal(`{ Service = "dynamodb" }`, ""),
+				ExpectError: regexache.MustCompile(`Principal.Service must be a service principal`),
+			},
+			{
+				Config:      testAccResourcePolicyConfig_principal(`"everyone"`, ""),
+				ExpectError: regexache.MustCompile(`Principal must be "\*" or an object`),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBResourcePolicy_policyTooLarge(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourcePolicyConfig_principal(`"*"`, strings.Repeat("a", 20*1024)),
+				ExpectError: regexache.MustCompile(`policy size must be at most 20480 bytes`),
+			},
+		},
+	})
+}
+
 func testAccCheckResourcePolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
@@ -196,3 +239,24 @@ resource "aws_dynamodb_resource_policy" "test" {
 }
 `)
 }
+
+func testAccResourcePolicyConfig_principal(principal, sid string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+
+resource "aws_dynamodb_resource_policy" "test" {
+  resource_arn = "arn:${data.aws_partition.current.partition}:dynamodb:${data.aws_region.current.name}:123456789012:table/test"
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid       = %[2]q
+      Effect    = "Allow"
+      Principal = %[1]s
+      Action    = "dynamodb:GetItem"
+      Resource  = "*"
+    }]
+  })
+}
+`, principal, sid)
+}
@@ -176,6 +176,12 @@ func resourceTable() *schema.Resource {
 							Optional: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"on_demand_throughput": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     onDemandThroughputElem(),
+						},
 						"projection_type": {
 							Type:             schema.TypeString,
 							Required:         true,
@@ -239,6 +245,12 @@ func resourceTable() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"on_demand_throughput": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     onDemandThroughputElem(),
+			},
 			"point_in_time_recovery": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -272,6 +284,33 @@ func resourceTable() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"deletion_protection_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"global_secondary_index": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_read_request_units_override": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"read_capacity_override": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
 						names.AttrKMSKeyARN: {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -472,6 +511,23 @@ func resourceTable() *schema.Resource {
 	}
 }
 
+func onDemandThroughputElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"max_read_request_units": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"max_write_request_units": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
 func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
@@ -656,6 +712,10 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 			input.LocalSecondaryIndexes = expandLocalSecondaryIndexes(lsiSet.List(), keySchemaMap)
 		}
 
+		if v, ok := d.GetOk("on_demand_throughput"); ok && billingMode == awstypes.BillingModePayPerRequest {
+			input.OnDemandThroughput = expandOnDemandThroughput(v.([]interface{}))
+		}
+
 		if v, ok := d.GetOk("global_secondary_index"); ok {
 			globalSecondaryIndexes := []awstypes.GlobalSecondaryIndex{}
 			gsiSet := v.(*schema.Set)
@@ -780,6 +840,10 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 
 	d.Set("deletion_protection_enabled", table.DeletionProtectionEnabled)
 
+	if err := d.Set("on_demand_throughput", flattenOnDemandThroughput(table.OnDemandThroughput)); err != nil {
+		return create.AppendDiagSettingError(diags, names.DynamoDB, resNameTable, d.Id(), "on_demand_throughput", err)
+	}
+
 	if table.ProvisionedThroughput != nil {
 		d.Set("write_capacity", table.ProvisionedThroughput.WriteCapacityUnits)
 		d.Set("read_capacity", table.ProvisionedThroughput.ReadCapacityUnits)
@@ -960,6 +1024,11 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.DeletionProtectionEnabled = aws.Bool(d.Get("deletion_protection_enabled").(bool))
 	}
 
+	if d.HasChange("on_demand_throughput") && newBillingMode == awstypes.BillingModePayPerRequest {
+		hasTableUpdate = true
+		input.OnDemandThroughput = expandOnDemandThroughputUpdate(d.Get("on_demand_throughput").([]interface{}))
+	}
+
 	// make change when
 	//   stream_enabled has change (below) OR
 	//   stream_view_type has change and stream_enabled is true (special case)
@@ -987,17 +1056,19 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	// Phase 2 of Global Secondary Index Operations: Update Only
 	// Cannot create or delete index while updating table ProvisionedThroughput
-	// Must skip all index updates when switching BillingMode from PROVISIONED to PAY_PER_REQUEST
+	// Must skip all index capacity updates when switching BillingMode from PROVISIONED to PAY_PER_REQUEST
 	// Must update all indexes when switching BillingMode from PAY_PER_REQUEST to PROVISIONED
-	if newBillingMode == awstypes.BillingModeProvisioned {
-		for _, gsiUpdate := range gsiUpdates {
-			if gsiUpdate.Update == nil {
-				continue
-			}
+	for _, gsiUpdate := range gsiUpdates {
+		if gsiUpdate.Update == nil {
+			continue
+		}
 
-			hasTableUpdate = true
-			input.GlobalSecondaryIndexUpdates = append(input.GlobalSecondaryIndexUpdates, gsiUpdate)
+		if newBillingMode != awstypes.BillingModeProvisioned && gsiUpdate.Update.ProvisionedThroughput != nil {
+			continue
 		}
+
+		hasTableUpdate = true
+		input.GlobalSecondaryIndexUpdates = append(input.GlobalSecondaryIndexUpdates, gsiUpdate)
 	}
 
 	if hasTableUpdate {
@@ -1246,6 +1317,10 @@ func createReplicas(ctx context.Context, conn *dynamodb.Client, tableName string
 			replicaInput.KMSMasterKeyId = aws.String(v)
 		}
 
+		if v, ok := tfMap["global_secondary_index"].(*schema.Set); ok && v.Len() > 0 {
+			replicaInput.GlobalSecondaryIndexes = expandReplicaGlobalSecondaryIndexes(v.List())
+		}
+
 		input := &dynamodb.UpdateTableInput{
 			TableName: aws.String(tableName),
 			ReplicaUpdates: []awstypes.ReplicationGroupUpdate{
@@ -1260,7 +1335,8 @@ func createReplicas(ctx context.Context, conn *dynamodb.Client, tableName string
 		//   kms_key_arn can't be updated - remove/add replica
 		//   propagate_tags - handled elsewhere
 		//   point_in_time_recovery - handled elsewhere
-		// if provisioned_throughput_override or table_class_override were added, they could be updated here
+		//   deletion_protection_enabled - handled elsewhere
+		//   global_secondary_index - updated here and in updateReplica
 		if !create {
 			var replicaInput = &awstypes.UpdateReplicationGroupMemberAction{}
 			if v, ok := tfMap["region_name"].(string); ok && v != "" {
@@ -1271,6 +1347,10 @@ func createReplicas(ctx context.Context, conn *dynamodb.Client, tableName string
 				replicaInput.KMSMasterKeyId = aws.String(v)
 			}
 
+			if v, ok := tfMap["global_secondary_index"].(*schema.Set); ok && v.Len() > 0 {
+				replicaInput.GlobalSecondaryIndexes = expandReplicaGlobalSecondaryIndexes(v.List())
+			}
+
 			input = &dynamodb.UpdateTableInput{
 				TableName: aws.String(tableName),
 				ReplicaUpdates: []awstypes.ReplicationGroupUpdate{
@@ -1326,6 +1406,57 @@ func createReplicas(ctx context.Context, conn *dynamodb.Client, tableName string
 		if err = updatePITR(ctx, conn, tableName, tfMap["point_in_time_recovery"].(bool), tfMap["region_name"].(string), timeout); err != nil {
 			return fmt.Errorf("updating replica (%s) point in time recovery: %w", tfMap["region_name"].(string), err)
 		}
+
+		if v, ok := tfMap["deletion_protection_enabled"].(bool); ok && v {
+			if err := updateReplicaDeletionProtection(ctx, conn, tableName, tfMap["region_name"].(string), v, timeout); err != nil {
+				return fmt.Errorf("updating replica (%s) deletion protection: %w", tfMap["region_name"].(string), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func updateReplicaDeletionProtection(ctx context.Context, conn *dynamodb.Client, tableName, region string, enabled bool, timeout time.Duration) error {
+	// deletion protection must be modified from region where the replica resides
+	optFn := func(o *dynamodb.Options) {
+		o.Region = region
+	}
+	input := &dynamodb.UpdateTableInput{
+		DeletionProtectionEnabled: aws.Bool(enabled),
+		TableName:                 aws.String(tableName),
+	}
+
+	if _, err := conn.UpdateTable(ctx, input, optFn); err != nil {
+		return err
+	}
+
+	if _, err := waitReplicaActive(ctx, conn, tableName, region, timeout); err != nil {
+		return fmt.Errorf("waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
+func updateReplicaGlobalSecondaryIndexes(ctx context.Context, conn *dynamodb.Client, tableName, region string, tfList []interface{}, timeout time.Duration) error {
+	input := &dynamodb.UpdateTableInput{
+		TableName: aws.String(tableName),
+		ReplicaUpdates: []awstypes.ReplicationGroupUpdate{
+			{
+				Update: &awstypes.UpdateReplicationGroupMemberAction{
+					GlobalSecondaryIndexes: expandReplicaGlobalSecondaryIndexes(tfList),
+					RegionName:             aws.String(region),
+				},
+			},
+		},
+	}
+
+	if _, err := conn.UpdateTable(ctx, input); err != nil {
+		return err
+	}
+
+	if _, err := waitReplicaActive(ctx, conn, tableName, region, timeout); err != nil {
+		return fmt.Errorf("waiting for completion: %w", err)
 	}
 
 	return nil
@@ -1499,15 +1630,41 @@ func updateReplica(ctx context.Context, conn *dynamodb.Client, d *schema.Resourc
 				break
 			}
 
-			// just update PITR
+			region := ma["region_name"].(string)
+
+			// update replica index settings in place
+			if o, n := mr["global_secondary_index"].(*schema.Set), ma["global_secondary_index"].(*schema.Set); !o.Equal(n) {
+				// Indexes whose overrides were removed are sent without overrides to clear them.
+				tfList := n.List()
+				for _, tfMapRaw := range o.Difference(n).List() {
+					name := tfMapRaw.(map[string]interface{})[names.AttrName].(string)
+					if !slices.ContainsFunc(tfList, func(v interface{}) bool {
+						return v.(map[string]interface{})[names.AttrName].(string) == name
+					}) {
+						tfList = append(tfList, map[string]interface{}{names.AttrName: name})
+					}
+				}
+
+				if err := updateReplicaGlobalSecondaryIndexes(ctx, conn, d.Id(), region, tfList, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("updating replica (%s) global secondary indexes: %w", region, err)
+				}
+			}
+
+			// update PITR
 			if ma["point_in_time_recovery"].(bool) != mr["point_in_time_recovery"].(bool) {
-				if err := updatePITR(ctx, conn, d.Id(), ma["point_in_time_recovery"].(bool), ma["region_name"].(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
-					return fmt.Errorf("updating replica (%s) point in time recovery: %w", ma["region_name"].(string), err)
+				if err := updatePITR(ctx, conn, d.Id(), ma["point_in_time_recovery"].(bool), region, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("updating replica (%s) point in time recovery: %w", region, err)
+				}
+			}
+
+			// update deletion protection, if configured
+			if replicaDeletionProtectionConfigured(d, region) && ma["deletion_protection_enabled"].(bool) != mr["deletion_protection_enabled"].(bool) {
+				if err := updateReplicaDeletionProtection(ctx, conn, d.Id(), region, ma["deletion_protection_enabled"].(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("updating replica (%s) deletion protection: %w", region, err)
 				}
-				break
 			}
 
-			// nothing changed, assuming propagate_tags changed so do nothing here
+			// otherwise, assuming propagate_tags changed so do nothing here
 			break
 		}
 	}
@@ -1564,6 +1721,7 @@ func updateDiffGSI(oldGsi, newGsi []interface{}, billingMode awstypes.BillingMod
 				Create: &awstypes.CreateGlobalSecondaryIndexAction{
 					IndexName:             aws.String(idxName),
 					KeySchema:             expandKeySchema(m),
+					OnDemandThroughput:    expandGSIOnDemandThroughput(m, billingMode),
 					ProvisionedThroughput: expandProvisionedThroughput(m, billingMode),
 					Projection:            expandProjection(m),
 				},
@@ -1582,7 +1740,8 @@ func updateDiffGSI(oldGsi, newGsi []interface{}, billingMode awstypes.BillingMod
 
 			oldWriteCapacity, oldReadCapacity := oldMap["write_capacity"].(int), oldMap["read_capacity"].(int)
 			newWriteCapacity, newReadCapacity := newMap["write_capacity"].(int), newMap["read_capacity"].(int)
-			capacityChanged := (oldWriteCapacity != newWriteCapacity || oldReadCapacity != newReadCapacity)
+			capacityChanged := (oldWriteCapacity != newWriteCapacity || oldReadCapacity != newReadCapacity) && billingMode == awstypes.BillingModeProvisioned
+			onDemandThroughputChanged := !reflect.DeepEqual(oldMap["on_demand_throughput"], newMap["on_demand_throughput"]) && billingMode == awstypes.BillingModePayPerRequest
 
 			// pluck non_key_attributes from oldAttributes and newAttributes as reflect.DeepEquals will compare
 			// ordinal of elements in its equality (which we actually don't care about)
@@ -1606,12 +1765,18 @@ func updateDiffGSI(oldGsi, newGsi []interface{}, billingMode awstypes.BillingMod
 			}
 			otherAttributesChanged := nonKeyAttributesChanged || !reflect.DeepEqual(oldAttributes, newAttributes)
 
-			if capacityChanged && !otherAttributesChanged {
+			if (capacityChanged || onDemandThroughputChanged) && !otherAttributesChanged {
+				action := &awstypes.UpdateGlobalSecondaryIndexAction{
+					IndexName: aws.String(idxName),
+				}
+				if capacityChanged {
+					action.ProvisionedThroughput = expandProvisionedThroughput(newMap, billingMode)
+				}
+				if onDemandThroughputChanged {
+					action.OnDemandThroughput = expandOnDemandThroughputUpdate(newMap["on_demand_throughput"].([]interface{}))
+				}
 				update := awstypes.GlobalSecondaryIndexUpdate{
-					Update: &awstypes.UpdateGlobalSecondaryIndexAction{
-						IndexName:             aws.String(idxName),
-						ProvisionedThroughput: expandProvisionedThroughput(newMap, billingMode),
-					},
+					Update: action,
 				}
 				ops = append(ops, update)
 			} else if otherAttributesChanged {
@@ -1626,6 +1791,7 @@ func updateDiffGSI(oldGsi, newGsi []interface{}, billingMode awstypes.BillingMod
 					Create: &awstypes.CreateGlobalSecondaryIndexAction{
 						IndexName:             aws.String(idxName),
 						KeySchema:             expandKeySchema(newMap),
+						OnDemandThroughput:    expandGSIOnDemandThroughput(newMap, billingMode),
 						ProvisionedThroughput: expandProvisionedThroughput(newMap, billingMode),
 						Projection:            expandProjection(newMap),
 					},
@@ -1827,6 +1993,8 @@ func enrichReplicas(ctx context.Context, conn *dynamodb.Client, arn, tableName s
 			tfMap[names.AttrKMSKeyARN] = aws.ToString(table.SSEDescription.KMSMasterKeyArn)
 		}
 
+		tfMap["deletion_protection_enabled"] = aws.ToBool(table.DeletionProtectionEnabled)
+
 		tfList[i] = tfMap
 	}
 
@@ -2000,12 +2168,37 @@ func flattenTableGlobalSecondaryIndex(gsi []awstypes.GlobalSecondaryIndexDescrip
 			gsi["non_key_attributes"] = g.Projection.NonKeyAttributes
 		}
 
+		gsi["on_demand_throughput"] = flattenOnDemandThroughput(g.OnDemandThroughput)
+
 		output = append(output, gsi)
 	}
 
 	return output
 }
 
+func flattenOnDemandThroughput(apiObject *awstypes.OnDemandThroughput) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	// -1 indicates that there is no maximum.
+	tfMap := make(map[string]interface{})
+
+	if v := aws.ToInt64(apiObject.MaxReadRequestUnits); v > 0 {
+		tfMap["max_read_request_units"] = v
+	}
+
+	if v := aws.ToInt64(apiObject.MaxWriteRequestUnits); v > 0 {
+		tfMap["max_write_request_units"] = v
+	}
+
+	if len(tfMap) == 0 {
+		return nil
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenTableServerSideEncryption(description *awstypes.SSEDescription) []interface{} {
 	if description == nil {
 		return []interface{}{}
@@ -2046,9 +2239,71 @@ func flattenReplicaDescription(apiObject *awstypes.ReplicaDescription) map[strin
 		tfMap["region_name"] = aws.ToString(apiObject.RegionName)
 	}
 
+	if v := flattenReplicaGlobalSecondaryIndexDescriptions(apiObject.GlobalSecondaryIndexes); len(v) > 0 {
+		tfMap["global_secondary_index"] = v
+	}
+
 	return tfMap
 }
 
+// flattenReplicaGlobalSecondaryIndexDescriptions returns only the indexes that override the table's settings.
+func flattenReplicaGlobalSecondaryIndexDescriptions(apiObjects []awstypes.ReplicaGlobalSecondaryIndexDescription) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrName: aws.ToString(apiObject.IndexName),
+		}
+
+		if v := apiObject.OnDemandThroughputOverride; v != nil && aws.ToInt64(v.MaxReadRequestUnits) > 0 {
+			tfMap["max_read_request_units_override"] = aws.ToInt64(v.MaxReadRequestUnits)
+		}
+
+		if v := apiObject.ProvisionedThroughputOverride; v != nil && aws.ToInt64(v.ReadCapacityUnits) > 0 {
+			tfMap["read_capacity_override"] = aws.ToInt64(v.ReadCapacityUnits)
+		}
+
+		if len(tfMap) == 1 {
+			continue
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func expandReplicaGlobalSecondaryIndexes(tfList []interface{}) []awstypes.ReplicaGlobalSecondaryIndex {
+	var apiObjects []awstypes.ReplicaGlobalSecondaryIndex
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.ReplicaGlobalSecondaryIndex{
+			IndexName: aws.String(tfMap[names.AttrName].(string)),
+		}
+
+		if v, ok := tfMap["max_read_request_units_override"].(int); ok && v > 0 {
+			apiObject.OnDemandThroughputOverride = &awstypes.OnDemandThroughputOverride{
+				MaxReadRequestUnits: aws.Int64(int64(v)),
+			}
+		}
+
+		if v, ok := tfMap["read_capacity_override"].(int); ok && v > 0 {
+			apiObject.ProvisionedThroughputOverride = &awstypes.ProvisionedThroughputOverride{
+				ReadCapacityUnits: aws.Int64(int64(v)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
 func flattenReplicaDescriptions(apiObjects []awstypes.ReplicaDescription) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
@@ -2150,11 +2405,55 @@ func expandGlobalSecondaryIndex(data map[string]interface{}, billingMode awstype
 	return &awstypes.GlobalSecondaryIndex{
 		IndexName:             aws.String(data[names.AttrName].(string)),
 		KeySchema:             expandKeySchema(data),
+		OnDemandThroughput:    expandGSIOnDemandThroughput(data, billingMode),
 		Projection:            expandProjection(data),
 		ProvisionedThroughput: expandProvisionedThroughput(data, billingMode),
 	}
 }
 
+func expandGSIOnDemandThroughput(data map[string]interface{}, billingMode awstypes.BillingMode) *awstypes.OnDemandThroughput {
+	if billingMode != awstypes.BillingModePayPerRequest {
+		return nil
+	}
+
+	v, _ := data["on_demand_throughput"].([]interface{})
+
+	return expandOnDemandThroughput(v)
+}
+
+func expandOnDemandThroughput(tfList []interface{}) *awstypes.OnDemandThroughput {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	return expandOnDemandThroughputUpdate(tfList)
+}
+
+// expandOnDemandThroughputUpdate returns the maximum on-demand throughput to set.
+// Units that are not configured are set to -1, which removes any existing maximum.
+func expandOnDemandThroughputUpdate(tfList []interface{}) *awstypes.OnDemandThroughput {
+	apiObject := &awstypes.OnDemandThroughput{
+		MaxReadRequestUnits:  aws.Int64(-1),
+		MaxWriteRequestUnits: aws.Int64(-1),
+	}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["max_read_request_units"].(int); ok && v > 0 {
+		apiObject.MaxReadRequestUnits = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_write_request_units"].(int); ok && v > 0 {
+		apiObject.MaxWriteRequestUnits = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
 func expandProvisionedThroughput(data map[string]interface{}, billingMode awstypes.BillingMode) *awstypes.ProvisionedThroughput {
 	return expandProvisionedThroughputUpdate("", data, billingMode, "")
 }
@@ -2392,6 +2691,32 @@ func validateProvisionedThroughputField(diff *schema.ResourceDiff, key string) e
 	return nil
 }
 
+// replicaDeletionProtectionConfigured returns whether deletion_protection_enabled is set in the configuration of the replica in the specified Region.
+func replicaDeletionProtectionConfigured(d *schema.ResourceData, region string) bool {
+	configRaw := d.GetRawConfig()
+	if !configRaw.IsKnown() || configRaw.IsNull() {
+		return false
+	}
+
+	replicas := configRaw.GetAttr("replica")
+	if !replicas.IsKnown() || replicas.IsNull() {
+		return false
+	}
+
+	for it := replicas.ElementIterator(); it.Next(); {
+		_, replica := it.Element()
+		if !replica.IsKnown() || replica.IsNull() {
+			continue
+		}
+
+		if v := replica.GetAttr("region_name"); v.IsKnown() && !v.IsNull() && v.AsString() == region {
+			return !replica.GetAttr("deletion_protection_enabled").IsNull()
+		}
+	}
+
+	return false
+}
+
 func validateTTLCustomDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 	var diags diag.Diagnostics
 
@@ -71,6 +71,42 @@ func resourceTableExport() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidUTCTimestamp,
 			},
+			"export_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ExportType](),
+			},
+			"incremental_export_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"export_from_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidUTCTimestamp,
+						},
+						"export_to_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidUTCTimestamp,
+						},
+						"export_view_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ExportViewType](),
+						},
+					},
+				},
+			},
 			"item_count": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -145,6 +181,14 @@ func resourceTableExportCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.ExportTime = aws.Time(v)
 	}
 
+	if v, ok := d.GetOk("export_type"); ok {
+		input.ExportType = awstypes.ExportType(v.(string))
+	}
+
+	if v, ok := d.GetOk("incremental_export_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IncrementalExportSpecification = expandIncrementalExportSpecification(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("s3_bucket_owner"); ok {
 		input.S3BucketOwner = aws.String(v.(string))
 	}
@@ -202,6 +246,10 @@ func resourceTableExportRead(ctx context.Context, d *schema.ResourceData, meta i
 	if desc.ExportTime != nil {
 		d.Set("export_time", aws.ToTime(desc.ExportTime).Format(time.RFC3339))
 	}
+	d.Set("export_type", desc.ExportType)
+	if err := d.Set("incremental_export_specification", flattenIncrementalExportSpecification(desc.IncrementalExportSpecification)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting incremental_export_specification: %s", err)
+	}
 	d.Set("item_count", desc.ItemCount)
 	d.Set("manifest_files_s3_key", desc.ExportManifest)
 	d.Set(names.AttrS3Bucket, desc.S3Bucket)
@@ -273,3 +321,47 @@ func waitTableExportCreated(ctx context.Context, conn *dynamodb.Client, id strin
 
 	return nil, err
 }
+
+func expandIncrementalExportSpecification(tfMap map[string]interface{}) *awstypes.IncrementalExportSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.IncrementalExportSpecification{}
+
+	if v, ok := tfMap["export_from_time"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.ExportFromTime = aws.Time(v)
+	}
+
+	if v, ok := tfMap["export_to_time"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.ExportToTime = aws.Time(v)
+	}
+
+	if v, ok := tfMap["export_view_type"].(string); ok && v != "" {
+		apiObject.ExportViewType = awstypes.ExportViewType(v)
+	}
+
+	return apiObject
+}
+
+func flattenIncrementalExportSpecification(apiObject *awstypes.IncrementalExportSpecification) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"export_view_type": apiObject.ExportViewType,
+	}
+
+	if v := apiObject.ExportFromTime; v != nil {
+		tfMap["export_from_time"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.ExportToTime; v != nil {
+		tfMap["export_to_time"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
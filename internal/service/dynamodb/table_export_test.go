@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
@@ -173,6 +174,55 @@ func TestAccDynamoDBTableExport_s3Prefix(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBTableExport_incrementalExport(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var tableexport awstypes.ExportDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_export.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DynamoDB)
+			testAccPreCheckTableExport(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableExportConfig_baseConfig(rName),
+			},
+			{
+				// The export period must be at least 15 minutes and start after point-in-time recovery is enabled.
+				PreConfig: func() {
+					time.Sleep(17 * time.Minute)
+				},
+				Config: testAccTableExportConfig_incrementalExport(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableExportExists(ctx, resourceName, &tableexport),
+					resource.TestCheckResourceAttr(resourceName, "export_status", "COMPLETED"),
+					resource.TestCheckResourceAttr(resourceName, "export_type", "INCREMENTAL_EXPORT"),
+					resource.TestCheckResourceAttr(resourceName, "incremental_export_specification.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "incremental_export_specification.0.export_from_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "incremental_export_specification.0.export_to_time"),
+					resource.TestCheckResourceAttr(resourceName, "incremental_export_specification.0.export_view_type", "NEW_AND_OLD_IMAGES"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest_files_s3_key"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckTableExportExists(ctx context.Context, n string, v *awstypes.ExportDescription) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -266,3 +316,21 @@ resource "aws_dynamodb_table_export" "test" {
   table_arn        = aws_dynamodb_table.test.arn
 }`, s3BucketPrefix))
 }
+
+func testAccTableExportConfig_incrementalExport(tableName string) string {
+	return acctest.ConfigCompose(testAccTableExportConfig_baseConfig(tableName), `
+resource "aws_dynamodb_table_export" "test" {
+  export_type = "INCREMENTAL_EXPORT"
+  s3_bucket   = aws_s3_bucket.test.id
+  table_arn   = aws_dynamodb_table.test.arn
+
+  incremental_export_specification {
+    export_from_time = formatdate("YYYY-MM-DD'T'hh:mm:00Z", timeadd(plantimestamp(), "-16m"))
+    export_to_time   = formatdate("YYYY-MM-DD'T'hh:mm:00Z", plantimestamp())
+  }
+
+  lifecycle {
+    ignore_changes = [incremental_export_specification]
+  }
+}`)
+}
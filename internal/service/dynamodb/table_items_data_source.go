@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_dynamodb_table_items", name="Table Items")
+func dataSourceTableItems() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTableItemsRead,
+
+		Schema: map[string]*schema.Schema{
+			"consistent_read": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"expression_attribute_names": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"expression_attribute_values": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateTableItem,
+			},
+			"filter_expression": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"index_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_condition_expression": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"projection_expression": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"scan_index_forward": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			names.AttrTableName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceTableItemsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+	input := &dynamodb.QueryInput{
+		ConsistentRead:         aws.Bool(d.Get("consistent_read").(bool)),
+		KeyConditionExpression: aws.String(d.Get("key_condition_expression").(string)),
+		ScanIndexForward:       aws.Bool(d.Get("scan_index_forward").(bool)),
+		TableName:              aws.String(tableName),
+	}
+
+	if v, ok := d.GetOk("expression_attribute_names"); ok && len(v.(map[string]interface{})) > 0 {
+		input.ExpressionAttributeNames = flex.ExpandStringValueMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("expression_attribute_values"); ok {
+		values, err := expandTableItemAttributes(v.(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.ExpressionAttributeValues = values
+	}
+
+	if v, ok := d.GetOk("filter_expression"); ok {
+		input.FilterExpression = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("index_name"); ok {
+		input.IndexName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("projection_expression"); ok {
+		input.ProjectionExpression = aws.String(v.(string))
+	}
+
+	items, err := findTableItems(ctx, conn, input, d.Get("limit").(int))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "querying DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	tfList := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		v, err := flattenTableItemAttributes(item)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		tfList = append(tfList, v)
+	}
+
+	d.SetId(tableName)
+	d.Set("items", tfList)
+
+	return diags
+}
+
+// findTableItems returns the items matching the query, stopping once limit items have been returned.
+// A limit of 0 returns all matching items.
+func findTableItems(ctx context.Context, conn *dynamodb.Client, input *dynamodb.QueryInput, limit int) ([]map[string]awstypes.AttributeValue, error) {
+	var output []map[string]awstypes.AttributeValue
+
+	pages := dynamodb.NewQueryPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Items {
+			output = append(output, v)
+
+			if limit > 0 && len(output) >= limit {
+				return output, nil
+			}
+		}
+	}
+
+	return output, nil
+}
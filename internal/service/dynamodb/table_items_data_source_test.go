@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDynamoDBTableItemsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, dynamodb.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsDataSourceConfig_basic(rName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "items.#", acctest.Ct3),
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "items.0", `{"app": {"S": "web"}, "name": {"S": "a"}, "value": {"N": "1"}}`),
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "items.2", `{"app": {"S": "web"}, "name": {"S": "c"}, "value": {"N": "3"}}`),
+				),
+			},
+			{
+				Config: testAccTableItemsDataSourceConfig_basic(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "items.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItemsDataSource_filterExpression(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, dynamodb.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsDataSourceConfig_filterExpression(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "items.#", acctest.Ct1),
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "items.0", `{"name": {"S": "c"}, "value": {"N": "3"}}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccTableItemsDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 10
+  write_capacity = 10
+  hash_key       = "app"
+  range_key      = "name"
+
+  attribute {
+    name = "app"
+    type = "S"
+  }
+
+  attribute {
+    name = "name"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table_item" "test" {
+  count = 3
+
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+
+  item = jsonencode({
+    app   = { S = "web" }
+    name  = { S = ["a", "b", "c"][count.index] }
+    value = { N = tostring(count.index + 1) }
+  })
+}
+
+resource "aws_dynamodb_table_item" "other" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+
+  item = jsonencode({
+    app  = { S = "worker" }
+    name = { S = "a" }
+  })
+}
+`, rName)
+}
+
+func testAccTableItemsDataSourceConfig_basic(rName string, limit int) string {
+	return acctest.ConfigCompose(testAccTableItemsDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_dynamodb_table_items" "test" {
+  table_name               = aws_dynamodb_table.test.name
+  key_condition_expression = "app = :app"
+  limit                    = %[1]d > 0 ? %[1]d : null
+
+  expression_attribute_values = jsonencode({
+    ":app" = { S = "web" }
+  })
+
+  depends_on = [aws_dynamodb_table_item.test, aws_dynamodb_table_item.other]
+}
+`, limit))
+}
+
+func testAccTableItemsDataSourceConfig_filterExpression(rName string) string {
+	return acctest.ConfigCompose(testAccTableItemsDataSourceConfig_base(rName), `
+data "aws_dynamodb_table_items" "test" {
+  table_name               = aws_dynamodb_table.test.name
+  key_condition_expression = "app = :app AND #name > :name"
+  filter_expression        = "#value >= :value"
+  projection_expression    = "#name, #value"
+
+  expression_attribute_names = {
+    "#name"  = "name"
+    "#value" = "value"
+  }
+
+  expression_attribute_values = jsonencode({
+    ":app"   = { S = "web" }
+    ":name"  = { S = "a" }
+    ":value" = { N = "3" }
+  })
+
+  depends_on = [aws_dynamodb_table_item.test, aws_dynamodb_table_item.other]
+}
+`)
+}
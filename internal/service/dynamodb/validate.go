@@ -4,11 +4,18 @@
 package dynamodb
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // http://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_CreateGlobalTable.html
@@ -50,3 +57,149 @@ func checkIfNonKeyAttributesChanged(oldMap, newMap map[string]interface{}) bool
 
 	return oldNkaExists != newNkaExists
 }
+
+// https://docs.aws.amazon.com/amazondynamodb/latest/developerguide/rbac-considerations.html.
+const (
+	resourcePolicyMaxSizeInBytes = 20 * 1024 // Whitespace counts towards the limit.
+)
+
+// resourcePolicyValidator validates a DynamoDB resource-based policy document's size and principals.
+type resourcePolicyValidator struct{}
+
+func (v resourcePolicyValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a resource-based policy of at most %d bytes with well-formed principals", resourcePolicyMaxSizeInBytes)
+}
+
+func (v resourcePolicyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v resourcePolicyValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if len(value) > resourcePolicyMaxSizeInBytes {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueLengthDiagnostic(
+			request.Path,
+			fmt.Sprintf("policy size must be at most %d bytes, got: %d", resourcePolicyMaxSizeInBytes, len(value)),
+			value,
+		))
+		return
+	}
+
+	var policy struct {
+		Statement json.RawMessage `json:"Statement"`
+	}
+
+	// Invalid JSON is reported by the attribute's type.
+	if err := json.Unmarshal([]byte(value), &policy); err != nil {
+		return
+	}
+
+	for _, err := range validResourcePolicyStatementPrincipals(policy.Statement) {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			err.Error(),
+			value,
+		))
+	}
+}
+
+func validResourcePolicyStatementPrincipals(raw json.RawMessage) []error {
+	type statement struct {
+		Principal    interface{} `json:"Principal"`
+		NotPrincipal interface{} `json:"NotPrincipal"`
+	}
+
+	if len(raw) == 0 {
+		return nil
+	}
+
+	// Statement can be a single object or an array of objects.
+	var statements []statement
+	if err := json.Unmarshal(raw, &statements); err != nil {
+		var s statement
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return []error{fmt.Errorf("Statement is not an object or array of objects: %w", err)}
+		}
+		statements = []statement{s}
+	}
+
+	var errs []error
+
+	for _, s := range statements {
+		for k, v := range map[string]interface{}{
+			"Principal":    s.Principal,
+			"NotPrincipal": s.NotPrincipal,
+		} {
+			if v == nil {
+				continue
+			}
+
+			errs = append(errs, validResourcePolicyPrincipal(k, v)...)
+		}
+	}
+
+	return errs
+}
+
+func validResourcePolicyPrincipal(k string, v interface{}) []error {
+	switch v := v.(type) {
+	case string:
+		if v != "*" {
+			return []error{fmt.Errorf("%s must be \"*\" or an object, got: %q", k, v)}
+		}
+		return nil
+	case map[string]interface{}:
+		var errs []error
+
+		for typ, v := range v {
+			var values []string
+
+			switch v := v.(type) {
+			case string:
+				values = append(values, v)
+			case []interface{}:
+				for _, v := range v {
+					s, ok := v.(string)
+					if !ok {
+						errs = append(errs, fmt.Errorf("%s.%s values must be strings, got: %v", k, typ, v))
+						continue
+					}
+					values = append(values, s)
+				}
+			default:
+				errs = append(errs, fmt.Errorf("%s.%s must be a string or array of strings, got: %v", k, typ, v))
+			}
+
+			for _, value := range values {
+				switch typ {
+				case "AWS":
+					if value == "*" || itypes.IsAWSAccountID(value) {
+						continue
+					}
+					if parsedARN, err := arn.Parse(value); err != nil || (parsedARN.Service != "iam" && parsedARN.Service != "sts") {
+						errs = append(errs, fmt.Errorf("%s.AWS must be \"*\", an AWS account ID or an IAM ARN, got: %q", k, value))
+					}
+				case "Service":
+					if !verify.IsServicePrincipal(value) {
+						errs = append(errs, fmt.Errorf("%s.Service must be a service principal, got: %q", k, value))
+					}
+				case "Federated", "CanonicalUser":
+					if value == "" {
+						errs = append(errs, fmt.Errorf("%s.%s must not be empty", k, typ))
+					}
+				default:
+					errs = append(errs, fmt.Errorf("%s has unsupported principal type %q", k, typ))
+				}
+			}
+		}
+
+		return errs
+	default:
+		return []error{fmt.Errorf("%s must be \"*\" or an object, got: %v", k, v)}
+	}
+}
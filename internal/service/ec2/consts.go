@@ -49,9 +49,12 @@ func SpotAllocationStrategy_Values() []string {
 
 const (
 	// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/spot-request-status.html#spot-instance-request-status-understand
-	spotInstanceRequestStatusCodeFulfilled          = "fulfilled"
-	spotInstanceRequestStatusCodePendingEvaluation  = "pending-evaluation"
-	spotInstanceRequestStatusCodePendingFulfillment = "pending-fulfillment"
+	spotInstanceRequestStatusCodeFulfilled            = "fulfilled"
+	spotInstanceRequestStatusCodeMarkedForHibernation = "marked-for-hibernation"
+	spotInstanceRequestStatusCodeMarkedForStop        = "marked-for-stop"
+	spotInstanceRequestStatusCodeMarkedForTermination = "marked-for-termination"
+	spotInstanceRequestStatusCodePendingEvaluation    = "pending-evaluation"
+	spotInstanceRequestStatusCodePendingFulfillment   = "pending-fulfillment"
 )
 
 const (
@@ -302,6 +305,15 @@ const (
 	ResInstanceState = "Instance State"
 )
 
+const (
+	// instanceStateHibernated is not an EC2 API instance state; a hibernated instance is reported as "stopped".
+	instanceStateHibernated = "hibernated"
+)
+
+const (
+	instanceStateReasonCodeUserInitiatedHibernate = "Client.UserInitiatedHibernate"
+)
+
 const (
 	gatewayIDLocal      = "local"
 	gatewayIDVPCLattice = "VpcLattice"
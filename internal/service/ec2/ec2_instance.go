@@ -1494,6 +1494,15 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 		}}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting instance_market_options: %s", err)
 		}
+	} else if instance.InstanceLifecycle == awstypes.InstanceLifecycleTypeCapacityBlock {
+		// Instances launched into a Capacity Block reservation have no associated request.
+		d.Set("instance_lifecycle", instance.InstanceLifecycle)
+		if err := d.Set("instance_market_options", []interface{}{map[string]interface{}{
+			"market_type": awstypes.MarketTypeCapacityBlock,
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting instance_market_options: %s", err)
+		}
+		d.Set("spot_instance_request_id", nil)
 	} else {
 		d.Set("instance_lifecycle", nil)
 		d.Set("instance_market_options", nil)
@@ -3169,6 +3178,27 @@ func stopInstance(ctx context.Context, conn *ec2.Client, id string, force bool,
 	return nil
 }
 
+// hibernateInstance hibernates an EC2 instance and waits for the instance to stop.
+func hibernateInstance(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) error {
+	tflog.Info(ctx, "Hibernating EC2 Instance", map[string]any{
+		"ec2_instance_id": id,
+	})
+	_, err := conn.StopInstances(ctx, &ec2.StopInstancesInput{
+		Hibernate:   aws.Bool(true),
+		InstanceIds: []string{id},
+	})
+
+	if err != nil {
+		return fmt.Errorf("hibernating EC2 Instance (%s): %w", id, err)
+	}
+
+	if _, err := waitInstanceStopped(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for EC2 Instance (%s) hibernate: %w", id, err)
+	}
+
+	return nil
+}
+
 // terminateInstance shuts down an EC2 instance and waits for the instance to be deleted.
 func terminateInstance(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) error {
 	log.Printf("[DEBUG] Terminating EC2 Instance: %s", id)
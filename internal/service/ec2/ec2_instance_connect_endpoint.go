@@ -45,6 +45,7 @@ func newInstanceConnectEndpointResource(context.Context) (resource.ResourceWithC
 
 type instanceConnectEndpointResource struct {
 	framework.ResourceWithConfigure
+	// There is no API to modify an endpoint, so every argument other than tags requires replacement.
 	framework.WithNoOpUpdate[instanceConnectEndpointResourceModel]
 	framework.WithImportByID
 	framework.WithTimeouts
@@ -5,6 +5,7 @@ package ec2
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -54,7 +55,12 @@ func resourceInstanceState() *schema.Resource {
 			names.AttrState: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validation.StringInSlice(enum.Slice(awstypes.InstanceStateNameRunning, awstypes.InstanceStateNameStopped), false),
+				ValidateFunc: validation.StringInSlice(append(enum.Slice(awstypes.InstanceStateNameRunning, awstypes.InstanceStateNameStopped), instanceStateHibernated), false),
+			},
+			"wait_for_status_checks": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -72,12 +78,18 @@ func resourceInstanceStateCreate(ctx context.Context, d *schema.ResourceData, me
 		return create.AppendDiagError(diags, names.EC2, create.ErrActionReading, ResInstance, instanceId, instanceErr)
 	}
 
-	err := updateInstanceState(ctx, conn, instanceId, string(instance.State.Name), d.Get(names.AttrState).(string), d.Get("force").(bool))
+	err := updateInstanceState(ctx, conn, instance, instanceStateName(instance), d.Get(names.AttrState).(string), d.Get("force").(bool))
 
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	if state := d.Get(names.AttrState).(string); state == string(awstypes.InstanceStateNameRunning) && d.Get("wait_for_status_checks").(bool) {
+		if _, err := waitInstanceStatusChecksPassed(ctx, conn, instanceId, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EC2 Instance (%s) status checks: %s", instanceId, err)
+		}
+	}
+
 	d.SetId(d.Get(names.AttrInstanceID).(string))
 
 	return append(diags, resourceInstanceStateRead(ctx, d, meta)...)
@@ -88,7 +100,7 @@ func resourceInstanceStateRead(ctx context.Context, d *schema.ResourceData, meta
 
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
-	state, err := findInstanceStateByID(ctx, conn, d.Id())
+	instance, err := findInstanceByID(ctx, conn, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		create.LogNotFoundRemoveState(names.EC2, create.ErrActionReading, ResInstanceState, d.Id())
@@ -101,8 +113,9 @@ func resourceInstanceStateRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	d.Set(names.AttrInstanceID, d.Id())
-	d.Set(names.AttrState, state.Name)
+	d.Set(names.AttrState, instanceStateName(instance))
 	d.Set("force", d.Get("force").(bool))
+	d.Set("wait_for_status_checks", d.Get("wait_for_status_checks").(bool))
 
 	return diags
 }
@@ -120,13 +133,21 @@ func resourceInstanceStateUpdate(ctx context.Context, d *schema.ResourceData, me
 
 	if d.HasChange(names.AttrState) {
 		o, n := d.GetChange(names.AttrState)
-		err := updateInstanceState(ctx, conn, d.Id(), o.(string), n.(string), d.Get("force").(bool))
+		err := updateInstanceState(ctx, conn, instance, o.(string), n.(string), d.Get("force").(bool))
 
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
 
+	if d.HasChanges(names.AttrState, "wait_for_status_checks") {
+		if state := d.Get(names.AttrState).(string); state == string(awstypes.InstanceStateNameRunning) && d.Get("wait_for_status_checks").(bool) {
+			if _, err := waitInstanceStatusChecksPassed(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for EC2 Instance (%s) status checks: %s", d.Id(), err)
+			}
+		}
+	}
+
 	return append(diags, resourceInstanceStateRead(ctx, d, meta)...)
 }
 
@@ -136,22 +157,81 @@ func resourceInstanceStateDelete(ctx context.Context, d *schema.ResourceData, me
 	return nil // nosemgrep:ci.semgrep.pluginsdk.return-diags-not-nil
 }
 
-func updateInstanceState(ctx context.Context, conn *ec2.Client, id string, currentState string, configuredState string, force bool) error {
+func updateInstanceState(ctx context.Context, conn *ec2.Client, instance *awstypes.Instance, currentState string, configuredState string, force bool) error {
 	if currentState == configuredState {
 		return nil
 	}
 
-	if configuredState == "stopped" {
+	id := aws.ToString(instance.InstanceId)
+
+	switch configuredState {
+	case string(awstypes.InstanceStateNameStopped):
+		// A hibernated instance is already stopped. Start it so that it can be stopped without hibernation.
+		if currentState == instanceStateHibernated {
+			if err := startInstance(ctx, conn, id, false, InstanceStartTimeout); err != nil {
+				return err
+			}
+		}
+
 		if err := stopInstance(ctx, conn, id, force, InstanceStopTimeout); err != nil {
 			return err
 		}
-	}
-
-	if configuredState == "running" {
+	case string(awstypes.InstanceStateNameRunning):
 		if err := startInstance(ctx, conn, id, false, InstanceStartTimeout); err != nil {
 			return err
 		}
+	case instanceStateHibernated:
+		if err := checkInstanceHibernationSupported(ctx, conn, instance); err != nil {
+			return err
+		}
+
+		// Only a running instance can be hibernated.
+		if currentState == string(awstypes.InstanceStateNameStopped) {
+			if err := startInstance(ctx, conn, id, false, InstanceStartTimeout); err != nil {
+				return err
+			}
+		}
+
+		if err := hibernateInstance(ctx, conn, id, InstanceStopTimeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkInstanceHibernationSupported returns an error if the specified instance cannot be hibernated.
+// Hibernation must be enabled at launch and is only available for supported instance types and AMIs.
+func checkInstanceHibernationSupported(ctx context.Context, conn *ec2.Client, instance *awstypes.Instance) error {
+	id := aws.ToString(instance.InstanceId)
+
+	if v := instance.HibernationOptions; v == nil || !aws.ToBool(v.Configured) {
+		return fmt.Errorf("EC2 Instance (%s) does not have hibernation enabled", id)
+	}
+
+	instanceType := string(instance.InstanceType)
+	instanceTypeInfo, err := findInstanceTypeByName(ctx, conn, instanceType)
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Instance Type (%s): %w", instanceType, err)
+	}
+
+	if !aws.ToBool(instanceTypeInfo.HibernationSupported) {
+		return fmt.Errorf("EC2 Instance (%s) type (%s) does not support hibernation", id, instanceType)
 	}
 
 	return nil
 }
+
+// instanceStateName returns the name of the instance's state, distinguishing hibernated from stopped instances.
+func instanceStateName(instance *awstypes.Instance) string {
+	name := instance.State.Name
+
+	if name == awstypes.InstanceStateNameStopped {
+		if v := instance.StateReason; v != nil && aws.ToString(v.Code) == instanceStateReasonCodeUserInitiatedHibernate {
+			return instanceStateHibernated
+		}
+	}
+
+	return string(name)
+}
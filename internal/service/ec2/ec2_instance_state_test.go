@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -77,6 +79,101 @@ func TestAccEC2InstanceState_state(t *testing.T) {
 	})
 }
 
+func TestAccEC2InstanceState_waitForStatusChecks(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_instance_state.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceStateConfig_waitForStatusChecks("running"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceStateExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "running"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_status_checks", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccInstanceStateConfig_waitForStatusChecks("stopped"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceStateExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "stopped"),
+				),
+			},
+			{
+				Config: testAccInstanceStateConfig_waitForStatusChecks("running"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceStateExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "running"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2InstanceState_hibernated(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_instance_state.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceStateConfig_hibernation(rName, "hibernated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceStateExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "hibernated"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force"},
+			},
+			{
+				Config: testAccInstanceStateConfig_hibernation(rName, "running"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceStateExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "running"),
+				),
+			},
+			{
+				Config: testAccInstanceStateConfig_hibernation(rName, "stopped"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceStateExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "stopped"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2InstanceState_hibernatedNotEnabled(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccInstanceStateConfig_basic("hibernated", acctest.CtFalse),
+				ExpectError: regexache.MustCompile(`does not have hibernation enabled`),
+			},
+		},
+	})
+}
+
 func TestAccEC2InstanceState_disappears_Instance(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_ec2_instance_state.test"
@@ -145,3 +242,30 @@ resource "aws_ec2_instance_state" "test" {
 }
 `, state, force))
 }
+
+func testAccInstanceStateConfig_hibernation(rName, state string) string {
+	return acctest.ConfigCompose(testAccInstanceConfig_hibernation(rName, true), fmt.Sprintf(`
+resource "aws_ec2_instance_state" "test" {
+  instance_id = aws_instance.test.id
+  state       = %[1]q
+}
+`, state))
+}
+
+func testAccInstanceStateConfig_waitForStatusChecks(state string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro", "t1.micro", "m1.small"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+}
+
+resource "aws_ec2_instance_state" "test" {
+  instance_id            = aws_instance.test.id
+  state                  = %[1]q
+  wait_for_status_checks = true
+}
+`, state))
+}
@@ -5363,6 +5363,45 @@ func TestAccEC2Instance_CapacityReservation_targetID(t *testing.T) {
 	})
 }
 
+func TestAccEC2Instance_CapacityReservation_capacityBlock(t *testing.T) {
+	ctx := acctest.Context(t)
+	reservationID := acctest.SkipIfEnvVarNotSet(t, "EC2_CAPACITY_BLOCK_RESERVATION_ID")
+	instanceType := acctest.SkipIfEnvVarNotSet(t, "EC2_CAPACITY_BLOCK_INSTANCE_TYPE")
+	var v awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_capacityReservationSpecificationCapacityBlock(rName, reservationID, instanceType),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "capacity_reservation_specification.0.capacity_reservation_target.0.capacity_reservation_id", reservationID),
+					resource.TestCheckResourceAttr(resourceName, "instance_lifecycle", string(awstypes.InstanceLifecycleTypeCapacityBlock)),
+					resource.TestCheckResourceAttr(resourceName, "instance_market_options.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "instance_market_options.0.market_type", string(awstypes.MarketTypeCapacityBlock)),
+					resource.TestCheckResourceAttr(resourceName, "spot_instance_request_id", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"user_data_replace_on_change"},
+			},
+			{
+				Config:   testAccInstanceConfig_capacityReservationSpecificationCapacityBlock(rName, reservationID, instanceType),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccEC2Instance_CapacityReservation_modifyPreference(t *testing.T) {
 	ctx := acctest.Context(t)
 	var original, updated awstypes.Instance
@@ -8884,6 +8923,31 @@ resource "aws_ec2_capacity_reservation" "test" {
 `, rName, awstypes.CapacityReservationInstancePlatformLinuxUnix))
 }
 
+func testAccInstanceConfig_capacityReservationSpecificationCapacityBlock(rName, reservationID, instanceType string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = %[3]q
+
+  instance_market_options {
+    market_type = "capacity-block"
+  }
+
+  capacity_reservation_specification {
+    capacity_reservation_target {
+      capacity_reservation_id = %[2]q
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, reservationID, instanceType))
+}
+
 func testAccInstanceConfig_templateBasic(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
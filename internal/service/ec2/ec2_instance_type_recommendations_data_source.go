@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_ec2_instance_type_recommendations", name="Instance Type Recommendations")
+func dataSourceInstanceTypeRecommendations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceInstanceTypeRecommendationsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"architecture_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[awstypes.ArchitectureType](),
+				},
+			},
+			"instance_requirements": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem:     instanceRequirementsSchema(),
+			},
+			"instance_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"virtualization_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[awstypes.VirtualizationType](),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceInstanceTypeRecommendationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	input := &ec2.GetInstanceTypesFromInstanceRequirementsInput{
+		ArchitectureTypes:   flex.ExpandStringyValueSet[awstypes.ArchitectureType](d.Get("architecture_types").(*schema.Set)),
+		VirtualizationTypes: flex.ExpandStringyValueSet[awstypes.VirtualizationType](d.Get("virtualization_types").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("instance_requirements"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.InstanceRequirements = expandInstanceRequirementsRequest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := findInstanceTypesFromInstanceRequirements(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Instance Type Recommendations: %s", err)
+	}
+
+	var instanceTypes []string
+
+	for _, v := range output {
+		instanceTypes = append(instanceTypes, aws.ToString(v.InstanceType))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("instance_types", instanceTypes)
+
+	return diags
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2InstanceTypeRecommendationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_instance_type_recommendations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceTypeRecommendationsDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "instance_types.#", 0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2InstanceTypeRecommendationsDataSource_accelerators(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_instance_type_recommendations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceTypeRecommendationsDataSourceConfig_accelerators,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "instance_types.#", 0),
+				),
+			},
+		},
+	})
+}
+
+const testAccInstanceTypeRecommendationsDataSourceConfig_basic = `
+data "aws_ec2_instance_type_recommendations" "test" {
+  architecture_types   = ["x86_64"]
+  virtualization_types = ["hvm"]
+
+  instance_requirements {
+    memory_mib {
+      min = 4096
+      max = 8192
+    }
+
+    vcpu_count {
+      min = 2
+      max = 4
+    }
+  }
+}
+`
+
+const testAccInstanceTypeRecommendationsDataSourceConfig_accelerators = `
+data "aws_ec2_instance_type_recommendations" "test" {
+  architecture_types   = ["x86_64"]
+  virtualization_types = ["hvm"]
+
+  instance_requirements {
+    accelerator_count {
+      min = 1
+    }
+
+    accelerator_manufacturers = ["nvidia"]
+    accelerator_types         = ["gpu"]
+
+    memory_mib {
+      min = 4096
+    }
+
+    vcpu_count {
+      min = 2
+    }
+  }
+}
+`
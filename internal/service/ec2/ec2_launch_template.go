@@ -347,282 +347,10 @@ func resourceLaunchTemplate() *schema.Resource {
 				},
 			},
 			"instance_requirements": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"accelerator_count": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrMax: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(0),
-									},
-									names.AttrMin: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-								},
-							},
-						},
-						"accelerator_manufacturers": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Schema{
-								Type:             schema.TypeString,
-								ValidateDiagFunc: enum.Validate[awstypes.AcceleratorManufacturer](),
-							},
-						},
-						"accelerator_names": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Schema{
-								Type:             schema.TypeString,
-								ValidateDiagFunc: enum.Validate[awstypes.AcceleratorName](),
-							},
-						},
-						"accelerator_total_memory_mib": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrMax: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-									names.AttrMin: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-								},
-							},
-						},
-						"accelerator_types": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Schema{
-								Type:             schema.TypeString,
-								ValidateDiagFunc: enum.Validate[awstypes.AcceleratorType](),
-							},
-						},
-						"allowed_instance_types": {
-							Type:          schema.TypeSet,
-							Optional:      true,
-							MaxItems:      400,
-							Elem:          &schema.Schema{Type: schema.TypeString},
-							ConflictsWith: []string{"instance_requirements.0.excluded_instance_types"},
-						},
-						"bare_metal": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: enum.Validate[awstypes.BareMetal](),
-						},
-						"baseline_ebs_bandwidth_mbps": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrMax: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-									names.AttrMin: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-								},
-							},
-						},
-						"burstable_performance": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: enum.Validate[awstypes.BurstablePerformance](),
-						},
-						"cpu_manufacturers": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Schema{
-								Type:             schema.TypeString,
-								ValidateDiagFunc: enum.Validate[awstypes.CpuManufacturer](),
-							},
-						},
-						"excluded_instance_types": {
-							Type:          schema.TypeSet,
-							Optional:      true,
-							MaxItems:      400,
-							Elem:          &schema.Schema{Type: schema.TypeString},
-							ConflictsWith: []string{"instance_requirements.0.allowed_instance_types"},
-						},
-						"instance_generations": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Schema{
-								Type:             schema.TypeString,
-								ValidateDiagFunc: enum.Validate[awstypes.InstanceGeneration](),
-							},
-						},
-						"local_storage": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: enum.Validate[awstypes.LocalStorage](),
-						},
-						"local_storage_types": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Schema{
-								Type:             schema.TypeString,
-								ValidateDiagFunc: enum.Validate[awstypes.LocalStorageType](),
-							},
-						},
-						"max_spot_price_as_percentage_of_optimal_on_demand_price": {
-							Type:          schema.TypeInt,
-							Optional:      true,
-							ValidateFunc:  validation.IntAtLeast(1),
-							ConflictsWith: []string{"instance_requirements.0.spot_max_price_percentage_over_lowest_price"},
-						},
-						"memory_gib_per_vcpu": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrMax: {
-										Type:         schema.TypeFloat,
-										Optional:     true,
-										ValidateFunc: verify.FloatGreaterThan(0.0),
-									},
-									names.AttrMin: {
-										Type:         schema.TypeFloat,
-										Optional:     true,
-										ValidateFunc: verify.FloatGreaterThan(0.0),
-									},
-								},
-							},
-						},
-						"memory_mib": {
-							Type:     schema.TypeList,
-							Required: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrMax: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-									names.AttrMin: {
-										Type:         schema.TypeInt,
-										Required:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-								},
-							},
-						},
-						"network_bandwidth_gbps": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrMax: {
-										Type:         schema.TypeFloat,
-										Optional:     true,
-										ValidateFunc: verify.FloatGreaterThan(0.0),
-									},
-									names.AttrMin: {
-										Type:         schema.TypeFloat,
-										Optional:     true,
-										ValidateFunc: verify.FloatGreaterThan(0.0),
-									},
-								},
-							},
-						},
-						"network_interface_count": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrMax: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-									names.AttrMin: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-								},
-							},
-						},
-						"on_demand_max_price_percentage_over_lowest_price": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntAtLeast(1),
-						},
-						"require_hibernate_support": {
-							Type:     schema.TypeBool,
-							Optional: true,
-						},
-						"spot_max_price_percentage_over_lowest_price": {
-							Type:          schema.TypeInt,
-							Optional:      true,
-							ValidateFunc:  validation.IntAtLeast(1),
-							ConflictsWith: []string{"instance_requirements.0.max_spot_price_as_percentage_of_optimal_on_demand_price"},
-						},
-						"total_local_storage_gb": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrMax: {
-										Type:         schema.TypeFloat,
-										Optional:     true,
-										ValidateFunc: verify.FloatGreaterThan(0.0),
-									},
-									names.AttrMin: {
-										Type:         schema.TypeFloat,
-										Optional:     true,
-										ValidateFunc: verify.FloatGreaterThan(0.0),
-									},
-								},
-							},
-						},
-						"vcpu_count": {
-							Type:     schema.TypeList,
-							Required: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrMax: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-									names.AttrMin: {
-										Type:         schema.TypeInt,
-										Required:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-								},
-							},
-						},
-					},
-				},
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				Elem:          instanceRequirementsSchema(),
 				ConflictsWith: []string{names.AttrInstanceType},
 			},
 			names.AttrInstanceType: {
@@ -1544,6 +1272,283 @@ func expandLaunchTemplateInstanceMarketOptionsRequest(tfMap map[string]interface
 	return apiObject
 }
 
+// instanceRequirementsSchema returns the schema for the instance type attribute requirements used to select instance types.
+func instanceRequirementsSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"accelerator_count": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrMax: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						names.AttrMin: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"accelerator_manufacturers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[awstypes.AcceleratorManufacturer](),
+				},
+			},
+			"accelerator_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[awstypes.AcceleratorName](),
+				},
+			},
+			"accelerator_total_memory_mib": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrMax: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						names.AttrMin: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"accelerator_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[awstypes.AcceleratorType](),
+				},
+			},
+			"allowed_instance_types": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      400,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"instance_requirements.0.excluded_instance_types"},
+			},
+			"bare_metal": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.BareMetal](),
+			},
+			"baseline_ebs_bandwidth_mbps": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrMax: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						names.AttrMin: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"burstable_performance": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.BurstablePerformance](),
+			},
+			"cpu_manufacturers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[awstypes.CpuManufacturer](),
+				},
+			},
+			"excluded_instance_types": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      400,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"instance_requirements.0.allowed_instance_types"},
+			},
+			"instance_generations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[awstypes.InstanceGeneration](),
+				},
+			},
+			"local_storage": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.LocalStorage](),
+			},
+			"local_storage_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[awstypes.LocalStorageType](),
+				},
+			},
+			"max_spot_price_as_percentage_of_optimal_on_demand_price": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntAtLeast(1),
+				ConflictsWith: []string{"instance_requirements.0.spot_max_price_percentage_over_lowest_price"},
+			},
+			"memory_gib_per_vcpu": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrMax: {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: verify.FloatGreaterThan(0.0),
+						},
+						names.AttrMin: {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: verify.FloatGreaterThan(0.0),
+						},
+					},
+				},
+			},
+			"memory_mib": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrMax: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						names.AttrMin: {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"network_bandwidth_gbps": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrMax: {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: verify.FloatGreaterThan(0.0),
+						},
+						names.AttrMin: {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: verify.FloatGreaterThan(0.0),
+						},
+					},
+				},
+			},
+			"network_interface_count": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrMax: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						names.AttrMin: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"on_demand_max_price_percentage_over_lowest_price": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"require_hibernate_support": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"spot_max_price_percentage_over_lowest_price": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntAtLeast(1),
+				ConflictsWith: []string{"instance_requirements.0.max_spot_price_as_percentage_of_optimal_on_demand_price"},
+			},
+			"total_local_storage_gb": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrMax: {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: verify.FloatGreaterThan(0.0),
+						},
+						names.AttrMin: {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: verify.FloatGreaterThan(0.0),
+						},
+					},
+				},
+			},
+			"vcpu_count": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrMax: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						names.AttrMin: {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func expandInstanceRequirementsRequest(tfMap map[string]interface{}) *awstypes.InstanceRequirementsRequest {
 	if tfMap == nil {
 		return nil
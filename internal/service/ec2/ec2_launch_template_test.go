@@ -10,6 +10,8 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -3068,6 +3070,60 @@ func TestAccEC2LaunchTemplate_enclaveOptions(t *testing.T) {
 	})
 }
 
+func TestAccEC2LaunchTemplate_outOfBandDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	var template awstypes.LaunchTemplate
+	resourceName := "aws_launch_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateConfig_outOfBandDrift(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "enclave_options.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "license_specification.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_name_options.0.hostname_type", "resource-name"),
+					testAccCheckLaunchTemplateCreateVersion(ctx, &template, "$Latest", &awstypes.RequestLaunchTemplateData{
+						EnclaveOptions: &awstypes.LaunchTemplateEnclaveOptionsRequest{
+							Enabled: aws.Bool(false),
+						},
+						PrivateDnsNameOptions: &awstypes.LaunchTemplatePrivateDnsNameOptionsRequest{
+							HostnameType: awstypes.HostnameTypeIpName,
+						},
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccLaunchTemplateConfig_outOfBandDrift(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "enclave_options.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_name_options.0.hostname_type", "resource-name"),
+					// An empty version drops all three blocks.
+					testAccCheckLaunchTemplateCreateVersion(ctx, &template, "", &awstypes.RequestLaunchTemplateData{}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccLaunchTemplateConfig_outOfBandDrift(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "enclave_options.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "license_specification.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_name_options.0.hostname_type", "resource-name"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2LaunchTemplate_hibernation(t *testing.T) {
 	ctx := acctest.Context(t)
 	var template awstypes.LaunchTemplate
@@ -3241,6 +3297,26 @@ func testAccCheckLaunchTemplateExists(ctx context.Context, n string, v *awstypes
 	}
 }
 
+// testAccCheckLaunchTemplateCreateVersion creates a new launch template version outside of Terraform.
+func testAccCheckLaunchTemplateCreateVersion(ctx context.Context, v *awstypes.LaunchTemplate, sourceVersion string, data *awstypes.RequestLaunchTemplateData) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		input := &ec2.CreateLaunchTemplateVersionInput{
+			LaunchTemplateData: data,
+			LaunchTemplateId:   v.LaunchTemplateId,
+		}
+
+		if sourceVersion != "" {
+			input.SourceVersion = aws.String(sourceVersion)
+		}
+
+		_, err := conn.CreateLaunchTemplateVersion(ctx, input)
+
+		return err
+	}
+}
+
 func testAccCheckLaunchTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
@@ -4170,6 +4246,31 @@ resource "aws_launch_template" "test" {
 `, rName, enabled)
 }
 
+func testAccLaunchTemplateConfig_outOfBandDrift(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_licensemanager_license_configuration" "test" {
+  name                  = %[1]q
+  license_counting_type = "vCPU"
+}
+
+resource "aws_launch_template" "test" {
+  name = %[1]q
+
+  enclave_options {
+    enabled = true
+  }
+
+  license_specification {
+    license_configuration_arn = aws_licensemanager_license_configuration.test.arn
+  }
+
+  private_dns_name_options {
+    hostname_type = "resource-name"
+  }
+}
+`, rName)
+}
+
 func testAccLaunchTemplateConfig_hibernation(rName string, enabled bool) string {
 	return fmt.Sprintf(`
 resource "aws_launch_template" "test" {
@@ -0,0 +1,398 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	schedulertypes "github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	scheduledInstanceStateActionStart = "start"
+	scheduledInstanceStateActionStop  = "stop"
+)
+
+var scheduledInstanceStateActions = []string{scheduledInstanceStateActionStart, scheduledInstanceStateActionStop}
+
+// @FrameworkResource("aws_ec2_scheduled_instance_state", name="Scheduled Instance State")
+func newScheduledInstanceStateResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &scheduledInstanceStateResource{}
+
+	return r, nil
+}
+
+type scheduledInstanceStateResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*scheduledInstanceStateResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ec2_scheduled_instance_state"
+}
+
+func (r *scheduledInstanceStateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	cronValidators := []validator.String{
+		stringvalidator.RegexMatches(regexache.MustCompile(`^\S+( \S+){5}$`), "must be a cron expression with six fields"),
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			names.AttrInstanceID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"start_cron": schema.StringAttribute{
+				Optional:   true,
+				Validators: cronValidators,
+			},
+			"start_schedule_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"stop_cron": schema.StringAttribute{
+				Optional:   true,
+				Validators: cronValidators,
+			},
+			"stop_schedule_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"timezone": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("UTC"),
+			},
+		},
+	}
+}
+
+func (r *scheduledInstanceStateResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("start_cron"),
+			path.MatchRoot("stop_cron"),
+		),
+	}
+}
+
+func (r *scheduledInstanceStateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data scheduledInstanceStateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SchedulerClient(ctx)
+
+	instanceID := data.InstanceID.ValueString()
+	crons := data.crons()
+	var created []string
+	for _, action := range scheduledInstanceStateActions {
+		cron := crons[action]
+		if cron.IsNull() {
+			continue
+		}
+
+		input := r.createScheduleInput(ctx, &data, action, cron.ValueString())
+
+		if _, err := conn.CreateSchedule(ctx, input); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("creating EC2 Scheduled Instance State (%s) %s schedule", instanceID, action), err.Error())
+
+			// Don't leave behind schedules that no resource in state owns.
+			for _, action := range created {
+				if err := deleteScheduledInstanceStateSchedule(ctx, conn, instanceID, action); err != nil {
+					response.Diagnostics.AddError(fmt.Sprintf("deleting EC2 Scheduled Instance State (%s) %s schedule", instanceID, action), err.Error())
+				}
+			}
+
+			return
+		}
+
+		created = append(created, action)
+	}
+
+	// Set values for unknowns.
+	data.ID = data.InstanceID
+
+	response.Diagnostics.Append(r.readSchedules(ctx, conn, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *scheduledInstanceStateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data scheduledInstanceStateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SchedulerClient(ctx)
+
+	data.InstanceID = data.ID
+	response.Diagnostics.Append(r.readSchedules(ctx, conn, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.StartCron.IsNull() && data.StopCron.IsNull() {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(fmt.Errorf("EC2 Scheduled Instance State (%s) schedules not found", data.ID.ValueString())))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *scheduledInstanceStateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new scheduledInstanceStateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SchedulerClient(ctx)
+
+	instanceID := new.InstanceID.ValueString()
+	oldCrons, newCrons := old.crons(), new.crons()
+	for _, action := range scheduledInstanceStateActions {
+		cron, oldCron := newCrons[action], oldCrons[action]
+
+		switch {
+		case cron.IsNull() && oldCron.IsNull():
+		case cron.IsNull():
+			if err := deleteScheduledInstanceStateSchedule(ctx, conn, instanceID, action); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("deleting EC2 Scheduled Instance State (%s) %s schedule", instanceID, action), err.Error())
+
+				return
+			}
+		case oldCron.IsNull():
+			input := r.createScheduleInput(ctx, &new, action, cron.ValueString())
+
+			if _, err := conn.CreateSchedule(ctx, input); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("creating EC2 Scheduled Instance State (%s) %s schedule", instanceID, action), err.Error())
+
+				return
+			}
+		case !cron.Equal(oldCron) || !new.RoleARN.Equal(old.RoleARN) || !new.Timezone.Equal(old.Timezone):
+			createInput := r.createScheduleInput(ctx, &new, action, cron.ValueString())
+			input := &scheduler.UpdateScheduleInput{
+				FlexibleTimeWindow:         createInput.FlexibleTimeWindow,
+				Name:                       createInput.Name,
+				ScheduleExpression:         createInput.ScheduleExpression,
+				ScheduleExpressionTimezone: createInput.ScheduleExpressionTimezone,
+				State:                      createInput.State,
+				Target:                     createInput.Target,
+			}
+
+			if _, err := conn.UpdateSchedule(ctx, input); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("updating EC2 Scheduled Instance State (%s) %s schedule", instanceID, action), err.Error())
+
+				return
+			}
+		}
+	}
+
+	response.Diagnostics.Append(r.readSchedules(ctx, conn, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *scheduledInstanceStateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data scheduledInstanceStateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SchedulerClient(ctx)
+
+	for _, action := range scheduledInstanceStateActions {
+		if err := deleteScheduledInstanceStateSchedule(ctx, conn, data.ID.ValueString(), action); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("deleting EC2 Scheduled Instance State (%s) %s schedule", data.ID.ValueString(), action), err.Error())
+
+			return
+		}
+	}
+}
+
+func (r *scheduledInstanceStateResource) createScheduleInput(ctx context.Context, data *scheduledInstanceStateResourceModel, action, cron string) *scheduler.CreateScheduleInput {
+	instanceID := data.InstanceID.ValueString()
+
+	return &scheduler.CreateScheduleInput{
+		FlexibleTimeWindow: &schedulertypes.FlexibleTimeWindow{
+			Mode: schedulertypes.FlexibleTimeWindowModeOff,
+		},
+		Name:                       aws.String(scheduledInstanceStateScheduleName(instanceID, action)),
+		ScheduleExpression:         aws.String(fmt.Sprintf("cron(%s)", cron)),
+		ScheduleExpressionTimezone: fwflex.StringFromFramework(ctx, data.Timezone),
+		State:                      schedulertypes.ScheduleStateEnabled,
+		Target: &schedulertypes.Target{
+			// Run the AWS-managed SSM Automation runbook via the Scheduler universal target.
+			Arn:     aws.String(fmt.Sprintf("arn:%s:scheduler:::aws-sdk:ssm:startAutomationExecution", r.Meta().Partition)),
+			Input:   aws.String(scheduledInstanceStateAutomationInput(instanceID, action)),
+			RoleArn: fwflex.StringFromFramework(ctx, data.RoleARN),
+		},
+	}
+}
+
+// readSchedules refreshes the model from the underlying EventBridge Scheduler schedules.
+func (r *scheduledInstanceStateResource) readSchedules(ctx context.Context, conn *scheduler.Client, data *scheduledInstanceStateResourceModel) (diags diag.Diagnostics) {
+	instanceID := data.InstanceID.ValueString()
+
+	data.StartCron, data.StartScheduleARN = types.StringNull(), types.StringNull()
+	data.StopCron, data.StopScheduleARN = types.StringNull(), types.StringNull()
+
+	for _, action := range scheduledInstanceStateActions {
+		output, err := findScheduledInstanceStateScheduleByName(ctx, conn, scheduledInstanceStateScheduleName(instanceID, action))
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("reading EC2 Scheduled Instance State (%s) %s schedule", instanceID, action), err.Error())
+
+			return diags
+		}
+
+		cron := types.StringValue(strings.TrimSuffix(strings.TrimPrefix(aws.ToString(output.ScheduleExpression), "cron("), ")"))
+		arn := fwflex.StringToFramework(ctx, output.Arn)
+
+		switch action {
+		case scheduledInstanceStateActionStart:
+			data.StartCron, data.StartScheduleARN = cron, arn
+		case scheduledInstanceStateActionStop:
+			data.StopCron, data.StopScheduleARN = cron, arn
+		}
+
+		data.Timezone = fwflex.StringToFramework(ctx, output.ScheduleExpressionTimezone)
+		if v := output.Target; v != nil {
+			data.RoleARN = fwtypes.ARNValue(aws.ToString(v.RoleArn))
+		}
+	}
+
+	return diags
+}
+
+type scheduledInstanceStateResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	InstanceID       types.String `tfsdk:"instance_id"`
+	RoleARN          fwtypes.ARN  `tfsdk:"role_arn"`
+	StartCron        types.String `tfsdk:"start_cron"`
+	StartScheduleARN types.String `tfsdk:"start_schedule_arn"`
+	StopCron         types.String `tfsdk:"stop_cron"`
+	StopScheduleARN  types.String `tfsdk:"stop_schedule_arn"`
+	Timezone         types.String `tfsdk:"timezone"`
+}
+
+func (data *scheduledInstanceStateResourceModel) crons() map[string]types.String {
+	return map[string]types.String{
+		scheduledInstanceStateActionStart: data.StartCron,
+		scheduledInstanceStateActionStop:  data.StopCron,
+	}
+}
+
+func scheduledInstanceStateScheduleName(instanceID, action string) string {
+	return fmt.Sprintf("terraform-%s-%s", instanceID, action)
+}
+
+func scheduledInstanceStateAutomationInput(instanceID, action string) string {
+	documentName := "AWS-StartEC2Instance"
+	if action == scheduledInstanceStateActionStop {
+		documentName = "AWS-StopEC2Instance"
+	}
+
+	input := struct {
+		DocumentName string              `json:"DocumentName"`
+		Parameters   map[string][]string `json:"Parameters"`
+	}{
+		DocumentName: documentName,
+		Parameters: map[string][]string{
+			"InstanceId": {instanceID},
+		},
+	}
+
+	v, _ := json.Marshal(input)
+
+	return string(v)
+}
+
+func findScheduledInstanceStateScheduleByName(ctx context.Context, conn *scheduler.Client, name string) (*scheduler.GetScheduleOutput, error) {
+	input := &scheduler.GetScheduleInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetSchedule(ctx, input)
+
+	if errs.IsA[*schedulertypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Arn == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func deleteScheduledInstanceStateSchedule(ctx context.Context, conn *scheduler.Client, instanceID, action string) error {
+	_, err := conn.DeleteSchedule(ctx, &scheduler.DeleteScheduleInput{
+		Name: aws.String(scheduledInstanceStateScheduleName(instanceID, action)),
+	})
+
+	if errs.IsA[*schedulertypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	return err
+}
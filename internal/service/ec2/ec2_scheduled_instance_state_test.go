@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2ScheduledInstanceState_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_scheduled_instance_state.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledInstanceStateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledInstanceStateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckScheduledInstanceStateExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, "aws_instance.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrInstanceID, "aws_instance.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckNoResourceAttr(resourceName, "start_cron"),
+					resource.TestCheckNoResourceAttr(resourceName, "start_schedule_arn"),
+					resource.TestCheckResourceAttr(resourceName, "stop_cron", "0 19 ? * MON-FRI *"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "stop_schedule_arn", "scheduler", regexache.MustCompile(`schedule/default/terraform-i-[0-9a-f]+-stop$`)),
+					resource.TestCheckResourceAttr(resourceName, "timezone", "UTC"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2ScheduledInstanceState_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_scheduled_instance_state.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledInstanceStateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledInstanceStateConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledInstanceStateExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceScheduledInstanceState, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2ScheduledInstanceState_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_scheduled_instance_state.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledInstanceStateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledInstanceStateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckScheduledInstanceStateExists(ctx, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "start_cron"),
+					resource.TestCheckResourceAttr(resourceName, "stop_cron", "0 19 ? * MON-FRI *"),
+					resource.TestCheckResourceAttr(resourceName, "timezone", "UTC"),
+				),
+			},
+			{
+				Config: testAccScheduledInstanceStateConfig_startStop(rName, "0 7 ? * MON-FRI *", "0 20 ? * MON-FRI *", "Europe/London"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckScheduledInstanceStateExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "start_cron", "0 7 ? * MON-FRI *"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_schedule_arn"),
+					resource.TestCheckResourceAttr(resourceName, "stop_cron", "0 20 ? * MON-FRI *"),
+					resource.TestCheckResourceAttrSet(resourceName, "stop_schedule_arn"),
+					resource.TestCheckResourceAttr(resourceName, "timezone", "Europe/London"),
+				),
+			},
+			{
+				Config: testAccScheduledInstanceStateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckScheduledInstanceStateExists(ctx, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "start_cron"),
+					resource.TestCheckNoResourceAttr(resourceName, "start_schedule_arn"),
+					resource.TestCheckResourceAttr(resourceName, "stop_cron", "0 19 ? * MON-FRI *"),
+					resource.TestCheckResourceAttr(resourceName, "timezone", "UTC"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckScheduledInstanceStateExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SchedulerClient(ctx)
+
+		for _, action := range []string{"start", "stop"} {
+			if rs.Primary.Attributes[action+"_cron"] == "" {
+				continue
+			}
+
+			if _, err := tfec2.FindScheduledInstanceStateScheduleByName(ctx, conn, tfec2.ScheduledInstanceStateScheduleName(rs.Primary.ID, action)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckScheduledInstanceStateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SchedulerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_scheduled_instance_state" {
+				continue
+			}
+
+			for _, action := range []string{"start", "stop"} {
+				_, err := tfec2.FindScheduledInstanceStateScheduleByName(ctx, conn, tfec2.ScheduledInstanceStateScheduleName(rs.Primary.ID, action))
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("EC2 Scheduled Instance State %s %s schedule still exists", rs.Primary.ID, action)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccScheduledInstanceStateConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro", "t1.micro", "m1.small"),
+		fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = "sts:AssumeRole"
+      Principal = {
+        Service = "scheduler.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["ssm:StartAutomationExecution", "ec2:DescribeInstanceStatus", "ec2:StartInstances", "ec2:StopInstances"]
+      Resource = "*"
+    }]
+  })
+}
+`, rName))
+}
+
+func testAccScheduledInstanceStateConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccScheduledInstanceStateConfig_base(rName), `
+resource "aws_ec2_scheduled_instance_state" "test" {
+  instance_id = aws_instance.test.id
+  role_arn    = aws_iam_role.test.arn
+  stop_cron   = "0 19 ? * MON-FRI *"
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`)
+}
+
+func testAccScheduledInstanceStateConfig_startStop(rName, startCron, stopCron, timezone string) string {
+	return acctest.ConfigCompose(testAccScheduledInstanceStateConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_scheduled_instance_state" "test" {
+  instance_id = aws_instance.test.id
+  role_arn    = aws_iam_role.test.arn
+  start_cron  = %[1]q
+  stop_cron   = %[2]q
+  timezone    = %[3]q
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, startCron, stopCron, timezone))
+}
@@ -81,6 +81,10 @@ func resourceSpotInstanceRequest() *schema.Resource {
 				ForceNew:         true,
 				ValidateDiagFunc: enum.Validate[awstypes.InstanceInterruptionBehavior](),
 			}
+			s["interruption_notice_state"] = &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			}
 			s["launch_group"] = &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -264,6 +268,7 @@ func resourceSpotInstanceRequestRead(ctx context.Context, d *schema.ResourceData
 	request := outputRaw.(*awstypes.SpotInstanceRequest)
 
 	d.Set("spot_bid_status", request.Status.Code)
+	d.Set("interruption_notice_state", spotInstanceInterruptionNoticeState(aws.ToString(request.Status.Code)))
 	// Instance ID is not set if the request is still pending
 	if request.InstanceId != nil {
 		d.Set("spot_instance_id", request.InstanceId)
@@ -326,6 +331,17 @@ func resourceSpotInstanceRequestDelete(ctx context.Context, d *schema.ResourceDa
 	return diags
 }
 
+// spotInstanceInterruptionNoticeState returns the interruption notice state for the specified Spot request status code.
+// See https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/spot-request-status.html#spot-instance-request-status-understand.
+func spotInstanceInterruptionNoticeState(statusCode string) string {
+	switch statusCode {
+	case spotInstanceRequestStatusCodeMarkedForHibernation, spotInstanceRequestStatusCodeMarkedForStop, spotInstanceRequestStatusCodeMarkedForTermination:
+		return statusCode
+	default:
+		return ""
+	}
+}
+
 func readInstance(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
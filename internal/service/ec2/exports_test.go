@@ -55,6 +55,8 @@ var (
 	ResourcePlacementGroup                           = resourcePlacementGroup
 	ResourceRoute                                    = resourceRoute
 	ResourceRouteTable                               = resourceRouteTable
+	ResourceRouteTableRoutes                         = resourceRouteTableRoutes
+	ResourceScheduledInstanceState                   = newScheduledInstanceStateResource
 	ResourceSecurityGroupEgressRule                  = newSecurityGroupEgressRuleResource
 	ResourceSecurityGroupIngressRule                 = newSecurityGroupIngressRuleResource
 	ResourceSnapshotCreateVolumePermission           = resourceSnapshotCreateVolumePermission
@@ -136,6 +138,7 @@ var (
 	FindRouteByPrefixListIDDestination                         = findRouteByPrefixListIDDestination
 	FindRouteTableAssociationByID                              = findRouteTableAssociationByID
 	FindRouteTableByID                                         = findRouteTableByID
+	FindScheduledInstanceStateScheduleByName                   = findScheduledInstanceStateScheduleByName
 	FindSnapshot                                               = findSnapshot
 	FindSnapshotByID                                           = findSnapshotByID
 	FindSpotDatafeedSubscription                               = findSpotDatafeedSubscription
@@ -191,6 +194,7 @@ var (
 	NewCustomFilterList                                        = newCustomFilterList
 	NewTagFilterList                                           = newTagFilterList
 	ProtocolForValue                                           = protocolForValue
+	ScheduledInstanceStateScheduleName                         = scheduledInstanceStateScheduleName
 	StopEBSVolumeAttachmentInstance                            = stopVolumeAttachmentInstance
 	StopInstance                                               = stopInstance
 	UpdateTags                                                 = updateTags
@@ -449,6 +449,39 @@ func findInstanceStateByID(ctx context.Context, conn *ec2.Client, id string) (*a
 	return output, nil
 }
 
+func findInstanceStatusByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.InstanceStatus, error) {
+	input := &ec2.DescribeInstanceStatusInput{
+		InstanceIds:         []string{id},
+		IncludeAllInstances: aws.Bool(true),
+	}
+
+	output, err := findInstanceStatus(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.InstanceState == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if name := output.InstanceState.Name; name == awstypes.InstanceStateNameTerminated {
+		return nil, &retry.NotFoundError{
+			Message:     string(name),
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.InstanceId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func findInstanceTypes(ctx context.Context, conn *ec2.Client, input *ec2.DescribeInstanceTypesInput) ([]awstypes.InstanceTypeInfo, error) {
 	var output []awstypes.InstanceTypeInfo
 
@@ -490,6 +523,23 @@ func findInstanceTypeByName(ctx context.Context, conn *ec2.Client, name string)
 	return output, nil
 }
 
+func findInstanceTypesFromInstanceRequirements(ctx context.Context, conn *ec2.Client, input *ec2.GetInstanceTypesFromInstanceRequirementsInput) ([]awstypes.InstanceTypeInfoFromInstanceRequirements, error) {
+	var output []awstypes.InstanceTypeInfoFromInstanceRequirements
+
+	pages := ec2.NewGetInstanceTypesFromInstanceRequirementsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.InstanceTypes...)
+	}
+
+	return output, nil
+}
+
 func findInstanceTypeOfferings(ctx context.Context, conn *ec2.Client, input *ec2.DescribeInstanceTypeOfferingsInput) ([]awstypes.InstanceTypeOffering, error) {
 	var output []awstypes.InstanceTypeOffering
 
@@ -3846,6 +3896,27 @@ func findTransitGatewayRoutes(ctx context.Context, conn *ec2.Client, input *ec2.
 	return output.Routes, err
 }
 
+func findTransitGatewayPolicyTableEntries(ctx context.Context, conn *ec2.Client, input *ec2.GetTransitGatewayPolicyTableEntriesInput) ([]awstypes.TransitGatewayPolicyTableEntry, error) {
+	output, err := conn.GetTransitGatewayPolicyTableEntries(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidTransitGatewayPolicyTableIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TransitGatewayPolicyTableEntries, err
+}
+
 func findTransitGatewayPolicyTable(ctx context.Context, conn *ec2.Client, input *ec2.DescribeTransitGatewayPolicyTablesInput) (*awstypes.TransitGatewayPolicyTable, error) {
 	output, err := findTransitGatewayPolicyTables(ctx, conn, input)
 
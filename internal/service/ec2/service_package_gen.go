@@ -26,6 +26,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newSecurityGroupRulesDataSource,
 			Name:    "Security Group Rules",
 		},
+		{
+			Factory: newSecurityGroupRulesAnalysisDataSource,
+			Name:    "Security Group Rules Analysis",
+		},
 	}
 }
 
@@ -65,6 +69,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceEndpointServicePrivateDNSVerification,
 			Name:    "Endpoint Service Private DNS Verification",
 		},
+		{
+			Factory: newScheduledInstanceStateResource,
+			Name:    "Scheduled Instance State",
+		},
 		{
 			Factory: newSecurityGroupEgressRuleResource,
 			Name:    "Security Group Egress Rule",
@@ -178,6 +186,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_ec2_instance_type_offerings",
 			Name:     "Instance Type Offering",
 		},
+		{
+			Factory:  dataSourceInstanceTypeRecommendations,
+			TypeName: "aws_ec2_instance_type_recommendations",
+			Name:     "Instance Type Recommendations",
+		},
 		{
 			Factory:  dataSourceInstanceTypes,
 			TypeName: "aws_ec2_instance_types",
@@ -303,6 +316,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_ec2_transit_gateway_peering_attachments",
 			Name:     "Transit Gateway Peering Attachments",
 		},
+		{
+			Factory:  dataSourceTransitGatewayPolicyTableEntries,
+			TypeName: "aws_ec2_transit_gateway_policy_table_entries",
+			Name:     "Transit Gateway Policy Table Entries",
+		},
 		{
 			Factory:  dataSourceTransitGatewayRouteTable,
 			TypeName: "aws_ec2_transit_gateway_route_table",
@@ -1287,6 +1305,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceVPCPeeringConnectionOptions,
 			TypeName: "aws_vpc_peering_connection_options",
 		},
+		{
+			Factory:  resourceRouteTableRoutes,
+			TypeName: "aws_vpc_route_table_routes",
+			Name:     "Route Table Routes",
+		},
 		{
 			Factory:  resourceVPNConnection,
 			TypeName: "aws_vpn_connection",
@@ -116,6 +116,40 @@ func statusInstance(ctx context.Context, conn *ec2.Client, id string) retry.Stat
 	}
 }
 
+// statusInstanceStatusChecks returns the combined status of an instance's system and instance status checks.
+func statusInstanceStatusChecks(ctx context.Context, conn *ec2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findInstanceStatusByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		var systemStatus, instanceStatus awstypes.SummaryStatus
+		if v := output.SystemStatus; v != nil {
+			systemStatus = v.Status
+		}
+		if v := output.InstanceStatus; v != nil {
+			instanceStatus = v.Status
+		}
+
+		switch {
+		case systemStatus == awstypes.SummaryStatusOk && instanceStatus == awstypes.SummaryStatusOk:
+			return output, string(awstypes.SummaryStatusOk), nil
+		case systemStatus == awstypes.SummaryStatusImpaired || instanceStatus == awstypes.SummaryStatusImpaired:
+			return output, string(awstypes.SummaryStatusImpaired), nil
+		case systemStatus == awstypes.SummaryStatusInsufficientData || instanceStatus == awstypes.SummaryStatusInsufficientData:
+			return output, string(awstypes.SummaryStatusInsufficientData), nil
+		default:
+			return output, string(awstypes.SummaryStatusInitializing), nil
+		}
+	}
+}
+
 func statusInstanceIAMInstanceProfile(ctx context.Context, conn *ec2.Client, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		instance, err := findInstanceByID(ctx, conn, id)
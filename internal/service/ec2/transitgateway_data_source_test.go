@@ -56,6 +56,9 @@ func TestAccTransitGatewayDataSource_serial(t *testing.T) {
 		"PeeringAttachments": {
 			"Filter": testAccTransitGatewayPeeringAttachmentsDataSource_Filter,
 		},
+		"PolicyTableEntries": {
+			acctest.CtBasic: testAccTransitGatewayPolicyTableEntriesDataSource_basic,
+		},
 		"RouteTable": {
 			"Filter": testAccTransitGatewayRouteTableDataSource_Filter,
 			"ID":     testAccTransitGatewayRouteTableDataSource_ID,
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ec2_transit_gateway_policy_table_entries", name="Transit Gateway Policy Table Entries")
+func dataSourceTransitGatewayPolicyTableEntries() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTransitGatewayPolicyTableEntriesRead,
+
+		Schema: map[string]*schema.Schema{
+			"entries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy_rule": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"destination_cidr_block": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"destination_port_range": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"meta_data_key": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"meta_data_value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrProtocol: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"source_cidr_block": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"source_port_range": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"policy_rule_number": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_route_table_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrFilter: customFiltersSchema(),
+			"transit_gateway_policy_table_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func dataSourceTransitGatewayPolicyTableEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	policyTableID := d.Get("transit_gateway_policy_table_id").(string)
+	input := &ec2.GetTransitGatewayPolicyTableEntriesInput{
+		Filters:                     newCustomFilterListV2(d.Get(names.AttrFilter).(*schema.Set)),
+		TransitGatewayPolicyTableId: aws.String(policyTableID),
+	}
+
+	if len(input.Filters) == 0 {
+		input.Filters = nil
+	}
+
+	output, err := findTransitGatewayPolicyTableEntries(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Policy Table (%s) Entries: %s", policyTableID, err)
+	}
+
+	d.SetId(policyTableID)
+
+	if err := d.Set("entries", flattenTransitGatewayPolicyTableEntries(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting entries: %s", err)
+	}
+
+	return diags
+}
+
+func flattenTransitGatewayPolicyTableEntries(apiObjects []awstypes.TransitGatewayPolicyTableEntry) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"policy_rule_number":    aws.ToString(apiObject.PolicyRuleNumber),
+			"target_route_table_id": aws.ToString(apiObject.TargetRouteTableId),
+		}
+
+		if v := apiObject.PolicyRule; v != nil {
+			tfMap["policy_rule"] = []interface{}{flattenTransitGatewayPolicyRule(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenTransitGatewayPolicyRule(apiObject *awstypes.TransitGatewayPolicyRule) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"destination_cidr_block": aws.ToString(apiObject.DestinationCidrBlock),
+		"destination_port_range": aws.ToString(apiObject.DestinationPortRange),
+		names.AttrProtocol:       aws.ToString(apiObject.Protocol),
+		"source_cidr_block":      aws.ToString(apiObject.SourceCidrBlock),
+		"source_port_range":      aws.ToString(apiObject.SourcePortRange),
+	}
+
+	if v := apiObject.MetaData; v != nil {
+		tfMap["meta_data_key"] = aws.ToString(v.MetaDataKey)
+		tfMap["meta_data_value"] = aws.ToString(v.MetaDataValue)
+	}
+
+	return tfMap
+}
@@ -46,3 +46,18 @@ func validNestedExactlyOneOf(m map[string]interface{}, valid []string) error {
 	}
 	return nil
 }
+
+var flowLogFormatFieldRegexp = regexache.MustCompile(`^\$\{[0-9a-z-]+\}$`)
+
+// validFlowLogFormat validates the syntax of a custom flow log record format.
+// Which fields are supported depends on the resource type and changes over time, so it is left to the EC2 API.
+func validFlowLogFormat(v interface{}, k string) (ws []string, errors []error) {
+	for _, token := range strings.Fields(v.(string)) {
+		if !flowLogFormatFieldRegexp.MatchString(token) {
+			errors = append(errors, fmt.Errorf(
+				"%q: %q is not a valid field reference, fields must be specified as ${field-name} separated by spaces", k, token))
+		}
+	}
+
+	return
+}
@@ -37,3 +37,31 @@ func TestValidSecurityGroupRuleDescription(t *testing.T) {
 		}
 	}
 }
+
+func TestValidFlowLogFormat(t *testing.T) {
+	t.Parallel()
+
+	validFormats := []string{
+		"${version} ${vpc-id}",
+		"${srcaddr}  ${dstaddr}",
+		"${version} ${tgw-id}",
+	}
+	for _, v := range validFormats {
+		_, errors := validFlowLogFormat(v, "log_format")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid flow log format: %q", v, errors)
+		}
+	}
+
+	invalidFormats := []string{
+		"${version},${vpc-id}",
+		"version vpc-id",
+		"${Version}",
+	}
+	for _, v := range invalidFormats {
+		_, errors := validFlowLogFormat(v, "log_format")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid flow log format", v)
+		}
+	}
+}
@@ -108,10 +108,11 @@ func ResourceFlowLog() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(ec2.LogDestinationType_Values(), false),
 			},
 			"log_format": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validFlowLogFormat,
 			},
 			names.AttrLogGroupName: {
 				Type:          schema.TypeString,
@@ -88,7 +88,6 @@ func ResourceNATGateway() *schema.Resource {
 				Type:          schema.TypeInt,
 				Optional:      true,
 				Computed:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"secondary_private_ip_addresses"},
 			},
 			"secondary_private_ip_addresses": {
@@ -222,7 +221,66 @@ func resourceNATGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	switch d.Get("connectivity_type").(string) {
 	case ec2.ConnectivityTypePrivate:
-		if d.HasChanges("secondary_private_ip_addresses") {
+		if v := d.GetRawConfig().GetAttr("secondary_private_ip_address_count"); d.HasChange("secondary_private_ip_address_count") && v.IsKnown() && !v.IsNull() {
+			oRaw, nRaw := d.GetChange("secondary_private_ip_address_count")
+			o, n := oRaw.(int), nRaw.(int)
+
+			if n > o {
+				input := &ec2.AssignPrivateNatGatewayAddressInput{
+					NatGatewayId:          aws.String(d.Id()),
+					PrivateIpAddressCount: aws.Int64(int64(n - o)),
+				}
+
+				output, err := conn.AssignPrivateNatGatewayAddressWithContext(ctx, input)
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "assigning EC2 NAT Gateway (%s) private IP addresses: %s", d.Id(), err)
+				}
+
+				for _, natGatewayAddress := range output.NatGatewayAddresses {
+					privateIP := aws.StringValue(natGatewayAddress.PrivateIp)
+					if _, err := WaitNATGatewayAddressAssigned(ctx, conn, d.Id(), privateIP, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						return sdkdiag.AppendErrorf(diags, "waiting for EC2 NAT Gateway (%s) private IP address (%s) assign: %s", d.Id(), privateIP, err)
+					}
+				}
+			} else if n < o {
+				natGateway, err := FindNATGatewayByID(ctx, conn, d.Id())
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "reading EC2 NAT Gateway (%s): %s", d.Id(), err)
+				}
+
+				var privateIPs []string
+
+				for _, natGatewayAddress := range natGateway.NatGatewayAddresses {
+					if !aws.BoolValue(natGatewayAddress.IsPrimary) {
+						privateIPs = append(privateIPs, aws.StringValue(natGatewayAddress.PrivateIp))
+					}
+				}
+
+				// Unassign the most recently listed secondary addresses.
+				if len(privateIPs) > o-n {
+					privateIPs = privateIPs[len(privateIPs)-(o-n):]
+				}
+
+				input := &ec2.UnassignPrivateNatGatewayAddressInput{
+					NatGatewayId:       aws.String(d.Id()),
+					PrivateIpAddresses: aws.StringSlice(privateIPs),
+				}
+
+				_, err = conn.UnassignPrivateNatGatewayAddressWithContext(ctx, input)
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "unassigning EC2 NAT Gateway (%s) private IP addresses: %s", d.Id(), err)
+				}
+
+				for _, privateIP := range privateIPs {
+					if _, err := WaitNATGatewayAddressUnassigned(ctx, conn, d.Id(), privateIP, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						return sdkdiag.AppendErrorf(diags, "waiting for EC2 NAT Gateway (%s) private IP address (%s) unassign: %s", d.Id(), privateIP, err)
+					}
+				}
+			}
+		} else if d.HasChanges("secondary_private_ip_addresses") {
 			oRaw, nRaw := d.GetChange("secondary_private_ip_addresses")
 			o, n := oRaw.(*schema.Set), nRaw.(*schema.Set)
 
@@ -373,6 +431,20 @@ func resourceNATGatewayCustomizeDiff(ctx context.Context, diff *schema.ResourceD
 			return fmt.Errorf(`secondary_allocation_ids is not supported with connectivity_type = "%s"`, connectivityType)
 		}
 
+		if diff.Id() != "" {
+			if v := diff.GetRawConfig().GetAttr("secondary_private_ip_address_count"); v.IsKnown() && !v.IsNull() && diff.HasChange("secondary_private_ip_address_count") {
+				if err := diff.SetNewComputed("secondary_private_ip_addresses"); err != nil {
+					return fmt.Errorf("setting secondary_private_ip_addresses to computed: %s", err)
+				}
+			}
+
+			if v := diff.GetRawConfig().GetAttr("secondary_private_ip_addresses"); v.IsKnown() && !v.IsNull() && diff.HasChange("secondary_private_ip_addresses") {
+				if err := diff.SetNewComputed("secondary_private_ip_address_count"); err != nil {
+					return fmt.Errorf("setting secondary_private_ip_address_count to computed: %s", err)
+				}
+			}
+		}
+
 	case ec2.ConnectivityTypePublic:
 		if v := diff.GetRawConfig().GetAttr("secondary_private_ip_address_count"); v.IsKnown() && !v.IsNull() {
 			return fmt.Errorf(`secondary_private_ip_address_count is not supported with connectivity_type = "%s"`, connectivityType)
@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_vpc_route_table_routes", name="Route Table Routes")
+func resourceRouteTableRoutes() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRouteTableRoutesCreate,
+		ReadWithoutTimeout:   resourceRouteTableRoutesRead,
+		UpdateWithoutTimeout: resourceRouteTableRoutesUpdate,
+		DeleteWithoutTimeout: resourceRouteTableRoutesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"route": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     routeTableRouteSchema(),
+				Set:      resourceRouteTableHash,
+			},
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRouteTableRoutesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	routeTableID := d.Get("route_table_id").(string)
+	routeTable, err := findRouteTableByID(ctx, conn, routeTableID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route Table (%s): %s", routeTableID, err)
+	}
+
+	d.SetId(routeTableID)
+
+	if err := routeTableRoutesReconcile(ctx, conn, routeTableID, flattenRoutes(ctx, conn, d, routeTable.Routes), d.Get("route").(*schema.Set).List(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return append(diags, resourceRouteTableRoutesRead(ctx, d, meta)...)
+}
+
+func resourceRouteTableRoutesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	routeTable, err := findRouteTableByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route Table (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("route", flattenRoutes(ctx, conn, d, routeTable.Routes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting route: %s", err)
+	}
+	d.Set("route_table_id", routeTable.RouteTableId)
+
+	return diags
+}
+
+func resourceRouteTableRoutesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	if d.HasChange("route") {
+		// Diff against the route table itself rather than prior state, which lacks routes created since the last refresh.
+		routeTable, err := findRouteTableByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Route Table (%s): %s", d.Id(), err)
+		}
+
+		if err := routeTableRoutesReconcile(ctx, conn, d.Id(), flattenRoutes(ctx, conn, d, routeTable.Routes), d.Get("route").(*schema.Set).List(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceRouteTableRoutesRead(ctx, d, meta)...)
+}
+
+func resourceRouteTableRoutesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	log.Printf("[INFO] Deleting Route Table (%s) routes", d.Id())
+	if err := routeTableRoutesReconcile(ctx, conn, d.Id(), d.Get("route").(*schema.Set).List(), nil, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+// routeTableRoutesReconcile adds, replaces and deletes routes in the specified route table so that the routes in `from` become the routes in `to`.
+// Routes are matched by destination. Local routes are never deleted.
+func routeTableRoutesReconcile(ctx context.Context, conn *ec2.Client, routeTableID string, from, to []interface{}, timeout time.Duration) error {
+	fromByDestination := make(map[string]map[string]interface{})
+
+	for _, v := range from {
+		tfMap := v.(map[string]interface{})
+		_, destination := routeTableRouteDestinationAttribute(tfMap)
+		fromByDestination[destination] = tfMap
+	}
+
+	var add, replace []map[string]interface{}
+	toDestinations := make(map[string]struct{})
+
+	for _, v := range to {
+		tfMap := v.(map[string]interface{})
+		_, destination := routeTableRouteDestinationAttribute(tfMap)
+		toDestinations[destination] = struct{}{}
+
+		old, ok := fromByDestination[destination]
+
+		if !ok {
+			add = append(add, tfMap)
+			continue
+		}
+
+		oldTargetKey, oldTarget := routeTableRouteTargetAttribute(old)
+		newTargetKey, newTarget := routeTableRouteTargetAttribute(tfMap)
+
+		if oldTargetKey != newTargetKey || oldTarget != newTarget {
+			replace = append(replace, tfMap)
+		}
+	}
+
+	// Delete first so that destinations moving between route entries do not conflict.
+	for destination, tfMap := range fromByDestination {
+		if _, ok := toDestinations[destination]; ok {
+			continue
+		}
+
+		if _, target := routeTableRouteTargetAttribute(tfMap); target == gatewayIDLocal {
+			continue
+		}
+
+		if err := routeTableDeleteRoute(ctx, conn, routeTableID, tfMap, timeout); err != nil {
+			return err
+		}
+	}
+
+	for _, tfMap := range replace {
+		if err := routeTableUpdateRoute(ctx, conn, routeTableID, tfMap, timeout); err != nil {
+			return err
+		}
+	}
+
+	for _, tfMap := range add {
+		if err := routeTableAddRoute(ctx, conn, routeTableID, tfMap, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCRouteTableRoutes_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var routeTable awstypes.RouteTable
+	resourceName := "aws_vpc_route_table_routes.test"
+	rtResourceName := "aws_route_table.test"
+	igwResourceName := "aws_internet_gateway.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, rtResourceName, &routeTable),
+					testAccCheckRouteTableNumberOfRoutes(&routeTable, 4),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, rtResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "route_table_id", rtResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "route.#", acctest.Ct2),
+					testAccCheckRouteTableRoute(resourceName, names.AttrCIDRBlock, "0.0.0.0/0", "gateway_id", igwResourceName, names.AttrID),
+					testAccCheckRouteTableRoute(resourceName, "ipv6_cidr_block", "::/0", "gateway_id", igwResourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteTableRoutes_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var routeTable awstypes.RouteTable
+	resourceName := "aws_vpc_route_table_routes.test"
+	rtResourceName := "aws_route_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, rtResourceName, &routeTable),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceRouteTableRoutes(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteTableRoutes_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var routeTable awstypes.RouteTable
+	resourceName := "aws_vpc_route_table_routes.test"
+	rtResourceName := "aws_route_table.test"
+	igwResourceName := "aws_internet_gateway.test"
+	eigwResourceName := "aws_egress_only_internet_gateway.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, rtResourceName, &routeTable),
+					testAccCheckRouteTableNumberOfRoutes(&routeTable, 4),
+					resource.TestCheckResourceAttr(resourceName, "route.#", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccVPCRouteTableRoutesConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, rtResourceName, &routeTable),
+					testAccCheckRouteTableNumberOfRoutes(&routeTable, 5),
+					resource.TestCheckResourceAttr(resourceName, "route.#", acctest.Ct3),
+					testAccCheckRouteTableRoute(resourceName, names.AttrCIDRBlock, "0.0.0.0/0", "gateway_id", igwResourceName, names.AttrID),
+					testAccCheckRouteTableRoute(resourceName, names.AttrCIDRBlock, "10.2.0.0/16", "gateway_id", igwResourceName, names.AttrID),
+					testAccCheckRouteTableRoute(resourceName, "ipv6_cidr_block", "::/0", "egress_only_gateway_id", eigwResourceName, names.AttrID),
+				),
+			},
+			{
+				Config: testAccVPCRouteTableRoutesConfig_empty(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, rtResourceName, &routeTable),
+					testAccCheckRouteTableNumberOfRoutes(&routeTable, 2),
+					resource.TestCheckResourceAttr(resourceName, "route.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteTableRoutes_exclusive(t *testing.T) {
+	ctx := acctest.Context(t)
+	var routeTable awstypes.RouteTable
+	resourceName := "aws_vpc_route_table_routes.test"
+	rtResourceName := "aws_route_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, rtResourceName, &routeTable),
+					testAccCheckVPCRouteTableRoutesAddRoute(ctx, &routeTable, "10.3.0.0/16"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVPCRouteTableRoutesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, rtResourceName, &routeTable),
+					testAccCheckRouteTableNumberOfRoutes(&routeTable, 4),
+					resource.TestCheckResourceAttr(resourceName, "route.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckVPCRouteTableRoutesAddRoute adds an internet gateway route outside of Terraform.
+func testAccCheckVPCRouteTableRoutesAddRoute(ctx context.Context, v *awstypes.RouteTable, destination string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		var gatewayID string
+		for _, route := range v.Routes {
+			if id := aws.ToString(route.GatewayId); id != "" && id != "local" {
+				gatewayID = id
+				break
+			}
+		}
+
+		if gatewayID == "" {
+			return fmt.Errorf("Route Table (%s) has no internet gateway route", aws.ToString(v.RouteTableId))
+		}
+
+		_, err := conn.CreateRoute(ctx, &ec2.CreateRouteInput{
+			DestinationCidrBlock: aws.String(destination),
+			GatewayId:            aws.String(gatewayID),
+			RouteTableId:         v.RouteTableId,
+		})
+
+		return err
+	}
+}
+
+func testAccVPCRouteTableRoutesConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block                       = "10.1.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_egress_only_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    ignore_changes = [route]
+  }
+}
+`, rName)
+}
+
+func testAccVPCRouteTableRoutesConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCRouteTableRoutesConfig_base(rName), `
+resource "aws_vpc_route_table_routes" "test" {
+  route_table_id = aws_route_table.test.id
+
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = aws_internet_gateway.test.id
+  }
+
+  route {
+    ipv6_cidr_block = "::/0"
+    gateway_id      = aws_internet_gateway.test.id
+  }
+}
+`)
+}
+
+func testAccVPCRouteTableRoutesConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccVPCRouteTableRoutesConfig_base(rName), `
+resource "aws_vpc_route_table_routes" "test" {
+  route_table_id = aws_route_table.test.id
+
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = aws_internet_gateway.test.id
+  }
+
+  route {
+    cidr_block = "10.2.0.0/16"
+    gateway_id = aws_internet_gateway.test.id
+  }
+
+  route {
+    ipv6_cidr_block        = "::/0"
+    egress_only_gateway_id = aws_egress_only_internet_gateway.test.id
+  }
+}
+`)
+}
+
+func testAccVPCRouteTableRoutesConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccVPCRouteTableRoutesConfig_base(rName), `
+resource "aws_vpc_route_table_routes" "test" {
+  route_table_id = aws_route_table.test.id
+}
+`)
+}
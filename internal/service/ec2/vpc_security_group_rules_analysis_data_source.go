@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_security_group_rules_analysis", name="Security Group Rules Analysis")
+func newSecurityGroupRulesAnalysisDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &securityGroupRulesAnalysisDataSource{}
+
+	return d, nil
+}
+
+type securityGroupRulesAnalysisDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*securityGroupRulesAnalysisDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_security_group_rules_analysis"
+}
+
+func (d *securityGroupRulesAnalysisDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	ruleAttribute := schema.ListNestedAttribute{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[securityGroupRulesAnalysisRuleModel](ctx),
+		Computed:   true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"cidr_ipv4": schema.StringAttribute{
+					Computed: true,
+				},
+				"cidr_ipv6": schema.StringAttribute{
+					Computed: true,
+				},
+				names.AttrDescription: schema.StringAttribute{
+					Computed: true,
+				},
+				"from_port": schema.Int64Attribute{
+					Computed: true,
+				},
+				"ip_protocol": schema.StringAttribute{
+					Computed: true,
+				},
+				"prefix_list_id": schema.StringAttribute{
+					Computed: true,
+				},
+				"reference_depth": schema.Int64Attribute{
+					Computed: true,
+				},
+				"referenced_security_group_id": schema.StringAttribute{
+					Computed: true,
+				},
+				"security_group_id": schema.StringAttribute{
+					Computed: true,
+				},
+				"security_group_rule_id": schema.StringAttribute{
+					Computed: true,
+				},
+				"to_port": schema.Int64Attribute{
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"egress":     ruleAttribute,
+			names.AttrID: framework.IDAttribute(),
+			"ingress":    ruleAttribute,
+			"referenced_security_group_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *securityGroupRulesAnalysisDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data securityGroupRulesAnalysisDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().EC2Conn(ctx)
+
+	id := data.SecurityGroupID.ValueString()
+	if _, err := FindSecurityGroupByID(ctx, conn, id); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Group (%s)", id), tfresource.SingularDataSourceFindError("Security Group", err).Error())
+
+		return
+	}
+
+	analyzer := &securityGroupRulesAnalyzer{
+		accountID: d.Meta().AccountID,
+		conn:      conn,
+		rules:     make(map[string][]*ec2.SecurityGroupRule),
+	}
+
+	ingress, ingressReferences, err := analyzer.analyze(ctx, id, false)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Group (%s) ingress rules", id), err.Error())
+
+		return
+	}
+
+	egress, egressReferences, err := analyzer.analyze(ctx, id, true)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Group (%s) egress rules", id), err.Error())
+
+		return
+	}
+
+	references := append(ingressReferences, egressReferences...)
+	slices.Sort(references)
+
+	data.Egress = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, egress)
+	data.ID = types.StringValue(id)
+	data.Ingress = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, ingress)
+	data.ReferencedSecurityGroupIDs = flex.FlattenFrameworkStringValueSet(ctx, slices.Compact(references))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// securityGroupRulesAnalyzer walks security group rules, following references to other security groups.
+type securityGroupRulesAnalyzer struct {
+	accountID string
+	conn      *ec2.EC2
+	rules     map[string][]*ec2.SecurityGroupRule
+}
+
+// analyze returns the ingress or egress rules of the specified security group together with the rules of the same direction
+// of any security groups that they reference, recursively, and the IDs of all referenced security groups.
+// Security groups in other accounts are reported as referenced but their rules are not read.
+func (a *securityGroupRulesAnalyzer) analyze(ctx context.Context, id string, isEgress bool) ([]securityGroupRulesAnalysisRuleModel, []string, error) {
+	var results []securityGroupRulesAnalysisRuleModel
+	var references []string
+	visited := map[string]struct{}{id: {}}
+
+	type item struct {
+		depth int64
+		id    string
+	}
+	queue := []item{{id: id}}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		rules, err := a.securityGroupRules(ctx, current.id)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, rule := range rules {
+			if aws.BoolValue(rule.IsEgress) != isEgress {
+				continue
+			}
+
+			results = append(results, securityGroupRulesAnalysisRuleModel{
+				CIDRIPv4:                  flex.StringToFramework(ctx, rule.CidrIpv4),
+				CIDRIPv6:                  flex.StringToFramework(ctx, rule.CidrIpv6),
+				Description:               flex.StringToFramework(ctx, rule.Description),
+				FromPort:                  flex.Int64ToFramework(ctx, rule.FromPort),
+				IPProtocol:                flex.StringToFramework(ctx, rule.IpProtocol),
+				PrefixListID:              flex.StringToFramework(ctx, rule.PrefixListId),
+				ReferenceDepth:            types.Int64Value(current.depth),
+				ReferencedSecurityGroupID: flattenReferencedSecurityGroup(ctx, rule.ReferencedGroupInfo, a.accountID),
+				SecurityGroupID:           flex.StringToFramework(ctx, rule.GroupId),
+				SecurityGroupRuleID:       flex.StringToFramework(ctx, rule.SecurityGroupRuleId),
+				ToPort:                    flex.Int64ToFramework(ctx, rule.ToPort),
+			})
+
+			v := rule.ReferencedGroupInfo
+			if v == nil || v.GroupId == nil {
+				continue
+			}
+
+			referencedID := aws.StringValue(v.GroupId)
+			if _, ok := visited[referencedID]; ok {
+				continue
+			}
+			visited[referencedID] = struct{}{}
+			references = append(references, referencedID)
+
+			if userID := aws.StringValue(v.UserId); userID != "" && userID != a.accountID {
+				continue
+			}
+
+			queue = append(queue, item{depth: current.depth + 1, id: referencedID})
+		}
+	}
+
+	return results, references, nil
+}
+
+func (a *securityGroupRulesAnalyzer) securityGroupRules(ctx context.Context, id string) ([]*ec2.SecurityGroupRule, error) {
+	if v, ok := a.rules[id]; ok {
+		return v, nil
+	}
+
+	rules, err := FindSecurityGroupRulesBySecurityGroupID(ctx, a.conn, id)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Security Group (%s) rules: %w", id, err)
+	}
+
+	a.rules[id] = rules
+
+	return rules, nil
+}
+
+type securityGroupRulesAnalysisDataSourceModel struct {
+	Egress                     fwtypes.ListNestedObjectValueOf[securityGroupRulesAnalysisRuleModel] `tfsdk:"egress"`
+	ID                         types.String                                                         `tfsdk:"id"`
+	Ingress                    fwtypes.ListNestedObjectValueOf[securityGroupRulesAnalysisRuleModel] `tfsdk:"ingress"`
+	ReferencedSecurityGroupIDs types.Set                                                            `tfsdk:"referenced_security_group_ids"`
+	SecurityGroupID            types.String                                                         `tfsdk:"security_group_id"`
+}
+
+type securityGroupRulesAnalysisRuleModel struct {
+	CIDRIPv4                  types.String `tfsdk:"cidr_ipv4"`
+	CIDRIPv6                  types.String `tfsdk:"cidr_ipv6"`
+	Description               types.String `tfsdk:"description"`
+	FromPort                  types.Int64  `tfsdk:"from_port"`
+	IPProtocol                types.String `tfsdk:"ip_protocol"`
+	PrefixListID              types.String `tfsdk:"prefix_list_id"`
+	ReferenceDepth            types.Int64  `tfsdk:"reference_depth"`
+	ReferencedSecurityGroupID types.String `tfsdk:"referenced_security_group_id"`
+	SecurityGroupID           types.String `tfsdk:"security_group_id"`
+	SecurityGroupRuleID       types.String `tfsdk:"security_group_rule_id"`
+	ToPort                    types.Int64  `tfsdk:"to_port"`
+}
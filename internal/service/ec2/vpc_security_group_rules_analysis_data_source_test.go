@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCSecurityGroupRulesAnalysisDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_security_group_rules_analysis.test"
+	sg1ResourceName := "aws_security_group.test"
+	sg2ResourceName := "aws_security_group.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesAnalysisDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, sg1ResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "egress.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "egress.*", map[string]string{
+						"cidr_ipv4":       "10.0.0.0/8",
+						"ip_protocol":     "-1",
+						"reference_depth": acctest.Ct0,
+					}),
+					// The cyclic reference back to the first security group is not followed again.
+					resource.TestCheckResourceAttr(dataSourceName, "ingress.#", acctest.Ct3),
+					resource.TestCheckTypeSetElemNestedAttrPair(dataSourceName, "ingress.*.referenced_security_group_id", sg2ResourceName, names.AttrID),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "ingress.*", map[string]string{
+						"cidr_ipv4":       "0.0.0.0/0",
+						"from_port":       "443",
+						"ip_protocol":     "tcp",
+						"reference_depth": acctest.Ct1,
+						"to_port":         "443",
+					}),
+					resource.TestCheckResourceAttr(dataSourceName, "referenced_security_group_ids.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "referenced_security_group_ids.*", sg2ResourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCSecurityGroupRulesAnalysisDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_security_group" "test2" {
+  vpc_id = aws_vpc.test.id
+  name   = "%[1]s-2"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  referenced_security_group_id = aws_security_group.test2.id
+  from_port                    = 80
+  ip_protocol                  = "tcp"
+  to_port                      = 80
+}
+
+resource "aws_vpc_security_group_egress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  ip_protocol = "-1"
+}
+
+resource "aws_vpc_security_group_ingress_rule" "test2_open" {
+  security_group_id = aws_security_group.test2.id
+
+  cidr_ipv4   = "0.0.0.0/0"
+  from_port   = 443
+  ip_protocol = "tcp"
+  to_port     = 443
+}
+
+resource "aws_vpc_security_group_ingress_rule" "test2_cycle" {
+  security_group_id = aws_security_group.test2.id
+
+  referenced_security_group_id = aws_security_group.test.id
+  from_port                    = 22
+  ip_protocol                  = "tcp"
+  to_port                      = 22
+}
+
+data "aws_security_group_rules_analysis" "test" {
+  security_group_id = aws_security_group.test.id
+
+  depends_on = [
+    aws_vpc_security_group_ingress_rule.test,
+    aws_vpc_security_group_egress_rule.test,
+    aws_vpc_security_group_ingress_rule.test2_open,
+    aws_vpc_security_group_ingress_rule.test2_cycle,
+  ]
+}
+`, rName))
+}
@@ -62,6 +62,25 @@ const (
 	CapacityReservationDeletedTimeout = 2 * time.Minute
 )
 
+func waitInstanceStatusChecksPassed(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.InstanceStatus, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.SummaryStatusInitializing, awstypes.SummaryStatusInsufficientData),
+		Target:     enum.Slice(awstypes.SummaryStatusOk),
+		Refresh:    statusInstanceStatusChecks(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.InstanceStatus); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitCapacityReservationActive(ctx context.Context, conn *ec2.Client, id string) error {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.CapacityReservationStatePending),
@@ -7,12 +7,17 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -38,7 +43,10 @@ func ResourceCapacityProvider() *schema.Resource {
 			StateContext: resourceCapacityProviderImport,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceCapacityProviderCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
@@ -112,6 +120,33 @@ func ResourceCapacityProvider() *schema.Resource {
 					},
 				},
 			},
+			"instance_refresh": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_warmup": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"min_healthy_percentage": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      90,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						names.AttrTriggers: {
+							Type:     schema.TypeMap,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
 			names.AttrName: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -199,7 +234,7 @@ func resourceCapacityProviderUpdate(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECSConn(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "instance_refresh") {
 		input := &ecs.UpdateCapacityProviderInput{
 			AutoScalingGroupProvider: expandAutoScalingGroupProviderUpdate(d.Get("auto_scaling_group_provider")),
 			Name:                     aws.String(d.Get(names.AttrName).(string)),
@@ -233,6 +268,14 @@ func resourceCapacityProviderUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	// Only a change to existing triggers starts an instance refresh, not adding or removing the instance_refresh block.
+	if o, n := d.GetChange("instance_refresh.0.triggers"); len(o.(map[string]interface{})) > 0 && len(n.(map[string]interface{})) > 0 && d.HasChange("instance_refresh.0.triggers") {
+		autoScalingGroupARN := d.Get("auto_scaling_group_provider.0.auto_scaling_group_arn").(string)
+		if err := startCapacityProviderInstanceRefresh(ctx, meta.(*conns.AWSClient).AutoScalingClient(ctx), autoScalingGroupARN, d.Get("instance_refresh.0").(map[string]interface{})); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating ECS Capacity Provider (%s): %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceCapacityProviderRead(ctx, d, meta)...)
 }
 
@@ -273,6 +316,62 @@ func resourceCapacityProviderImport(ctx context.Context, d *schema.ResourceData,
 	return []*schema.ResourceData{d}, nil
 }
 
+func resourceCapacityProviderCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Without managed draining, tasks are not drained from container instances terminated by an instance refresh.
+	if v, ok := d.GetOk("instance_refresh"); ok && len(v.([]interface{})) > 0 {
+		if v := d.Get("auto_scaling_group_provider.0.managed_draining").(string); v == ecs.ManagedDrainingDisabled {
+			return fmt.Errorf(`"instance_refresh" requires "auto_scaling_group_provider.0.managed_draining" to be %q`, ecs.ManagedDrainingEnabled)
+		}
+	}
+
+	return nil
+}
+
+// startCapacityProviderInstanceRefresh starts a rolling instance refresh of the capacity provider's Auto Scaling group.
+func startCapacityProviderInstanceRefresh(ctx context.Context, conn *autoscaling.Client, autoScalingGroupARN string, tfMap map[string]interface{}) error {
+	name, err := autoScalingGroupNameFromARN(autoScalingGroupARN)
+
+	if err != nil {
+		return err
+	}
+
+	input := &autoscaling.StartInstanceRefreshInput{
+		AutoScalingGroupName: aws_sdkv2.String(name),
+		Preferences: &awstypes.RefreshPreferences{
+			MinHealthyPercentage: aws_sdkv2.Int32(int32(tfMap["min_healthy_percentage"].(int))),
+		},
+		Strategy: awstypes.RefreshStrategyRolling,
+	}
+
+	if v, ok := tfMap["instance_warmup"].(int); ok && v > 0 {
+		input.Preferences.InstanceWarmup = aws_sdkv2.Int32(int32(v))
+	}
+
+	if _, err := conn.StartInstanceRefresh(ctx, input); err != nil {
+		return fmt.Errorf("starting Auto Scaling Group (%s) instance refresh: %w", name, err)
+	}
+
+	return nil
+}
+
+// autoScalingGroupNameFromARN returns the name of an Auto Scaling group from its ARN,
+// e.g. arn:aws:autoscaling:us-west-2:123456789012:autoScalingGroup:uuid:autoScalingGroupName/example.
+func autoScalingGroupNameFromARN(s string) (string, error) {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return "", err
+	}
+
+	_, name, found := strings.Cut(v.Resource, "autoScalingGroupName/")
+
+	if !found || name == "" {
+		return "", fmt.Errorf("unexpected format for Auto Scaling Group ARN (%s)", s)
+	}
+
+	return name, nil
+}
+
 func expandAutoScalingGroupProviderCreate(configured interface{}) *ecs.AutoScalingGroupProvider {
 	if configured == nil {
 		return nil
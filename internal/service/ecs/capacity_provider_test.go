@@ -8,6 +8,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -233,6 +236,65 @@ func TestAccECSCapacityProvider_tags(t *testing.T) {
 	})
 }
 
+func TestAccECSCapacityProvider_instanceRefresh(t *testing.T) {
+	ctx := acctest.Context(t)
+	var provider ecs.CapacityProvider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_capacity_provider.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityProviderConfig_instanceRefresh(rName, "t3.micro"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCapacityProviderExists(ctx, resourceName, &provider),
+					testAccCheckCapacityProviderInstanceRefreshCount(ctx, "aws_autoscaling_group.test", 0),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.instance_warmup", "60"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.min_healthy_percentage", "50"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.triggers.%", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateId:           rName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"instance_refresh"},
+			},
+			{
+				Config: testAccCapacityProviderConfig_instanceRefresh(rName, "t3.small"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCapacityProviderExists(ctx, resourceName, &provider),
+					testAccCheckCapacityProviderInstanceRefreshCount(ctx, "aws_autoscaling_group.test", 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSCapacityProvider_instanceRefreshManagedDrainingDisabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCapacityProviderConfig_instanceRefreshManagedDrainingDisabled(rName),
+				ExpectError: regexache.MustCompile(`"instance_refresh" requires "auto_scaling_group_provider.0.managed_draining" to be "ENABLED"`),
+			},
+		},
+	})
+}
+
 func testAccCheckCapacityProviderDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn(ctx)
@@ -284,6 +346,31 @@ func testAccCheckCapacityProviderExists(ctx context.Context, resourceName string
 	}
 }
 
+func testAccCheckCapacityProviderInstanceRefreshCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingClient(ctx)
+
+		output, err := conn.DescribeInstanceRefreshes(ctx, &autoscaling.DescribeInstanceRefreshesInput{
+			AutoScalingGroupName: aws_sdkv2.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output.InstanceRefreshes); got != want {
+			return fmt.Errorf("Auto Scaling Group (%s) instance refresh count = %d, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccCapacityProviderConfig_base(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigAvailableAZsNoOptInDefaultExclude(),
@@ -372,6 +459,81 @@ resource "aws_ecs_capacity_provider" "test" {
 `, rName))
 }
 
+func testAccCapacityProviderConfig_instanceRefresh(rName, instanceType string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAvailableAZsNoOptInDefaultExclude(),
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  image_id      = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = %[2]q
+  name          = %[1]q
+}
+
+resource "aws_autoscaling_group" "test" {
+  availability_zones = data.aws_availability_zones.available.names
+  desired_capacity   = 0
+  max_size           = 0
+  min_size           = 0
+  name               = %[1]q
+
+  launch_template {
+    id      = aws_launch_template.test.id
+    version = aws_launch_template.test.latest_version
+  }
+
+  tag {
+    key                 = "Name"
+    value               = %[1]q
+    propagate_at_launch = true
+  }
+
+  lifecycle {
+    ignore_changes = [
+      tag,
+    ]
+  }
+}
+
+resource "aws_ecs_capacity_provider" "test" {
+  name = %[1]q
+
+  auto_scaling_group_provider {
+    auto_scaling_group_arn = aws_autoscaling_group.test.arn
+  }
+
+  instance_refresh {
+    instance_warmup        = 60
+    min_healthy_percentage = 50
+
+    triggers = {
+      launch_template_version = aws_launch_template.test.latest_version
+    }
+  }
+}
+`, rName, instanceType))
+}
+
+func testAccCapacityProviderConfig_instanceRefreshManagedDrainingDisabled(rName string) string {
+	return acctest.ConfigCompose(testAccCapacityProviderConfig_base(rName), fmt.Sprintf(`
+resource "aws_ecs_capacity_provider" "test" {
+  name = %[1]q
+
+  auto_scaling_group_provider {
+    auto_scaling_group_arn = aws_autoscaling_group.test.arn
+
+    managed_draining = "DISABLED"
+  }
+
+  instance_refresh {
+    triggers = {
+      launch_template_version = aws_launch_template.test.latest_version
+    }
+  }
+}
+`, rName))
+}
+
 func testAccCapacityProviderConfig_tags1(rName, tag1Key, tag1Value string) string {
 	return acctest.ConfigCompose(testAccCapacityProviderConfig_base(rName), fmt.Sprintf(`
 resource "aws_ecs_capacity_provider" "test" {
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ecs_cluster_service_connect_defaults", name="Cluster Service Connect Defaults")
+func ResourceClusterServiceConnectDefaults() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceClusterServiceConnectDefaultsPut,
+		ReadWithoutTimeout:   resourceClusterServiceConnectDefaultsRead,
+		UpdateWithoutTimeout: resourceClusterServiceConnectDefaultsPut,
+		DeleteWithoutTimeout: resourceClusterServiceConnectDefaultsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrClusterName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateClusterName,
+			},
+			names.AttrNamespace: {
+				Type:     schema.TypeString,
+				Required: true,
+				// The API accepts both an ARN and a name, but always returns the ARN.
+				// Only ARNs are allowed so that a namespace changed outside Terraform is detected.
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceClusterServiceConnectDefaultsPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ECSConn(ctx)
+
+	clusterName := d.Get(names.AttrClusterName).(string)
+	input := &ecs.UpdateClusterInput{
+		Cluster: aws.String(clusterName),
+		ServiceConnectDefaults: &ecs.ClusterServiceConnectDefaultsRequest{
+			Namespace: aws.String(d.Get(names.AttrNamespace).(string)),
+		},
+	}
+
+	_, err := conn.UpdateClusterWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating ECS Cluster Service Connect Defaults (%s): %s", clusterName, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(clusterName)
+	}
+
+	if _, err := waitClusterAvailable(ctx, conn, clusterName); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ECS Cluster Service Connect Defaults (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceClusterServiceConnectDefaultsRead(ctx, d, meta)...)
+}
+
+func resourceClusterServiceConnectDefaultsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ECSConn(ctx)
+
+	cluster, err := FindClusterByNameOrARN(ctx, conn, d.Id())
+
+	if err == nil && (cluster.ServiceConnectDefaults == nil || aws.StringValue(cluster.ServiceConnectDefaults.Namespace) == "") {
+		err = tfresource.NewEmptyResultError(d.Id())
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ECS Cluster Service Connect Defaults (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECS Cluster Service Connect Defaults (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrClusterName, cluster.ClusterName)
+	d.Set(names.AttrNamespace, cluster.ServiceConnectDefaults.Namespace)
+
+	return diags
+}
+
+func resourceClusterServiceConnectDefaultsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ECSConn(ctx)
+
+	// An empty namespace removes the cluster's Service Connect defaults.
+	input := &ecs.UpdateClusterInput{
+		Cluster: aws.String(d.Id()),
+		ServiceConnectDefaults: &ecs.ClusterServiceConnectDefaultsRequest{
+			Namespace: aws.String(""),
+		},
+	}
+
+	log.Printf("[DEBUG] Deleting ECS Cluster Service Connect Defaults: %s", d.Id())
+	_, err := conn.UpdateClusterWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting ECS Cluster Service Connect Defaults (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitClusterAvailable(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ECS Cluster Service Connect Defaults (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECSClusterServiceConnectDefaults_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster ecs.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_cluster_service_connect_defaults.test"
+	namespace1ResourceName := "aws_service_discovery_http_namespace.test.0"
+	namespace2ResourceName := "aws_service_discovery_http_namespace.test.1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterServiceConnectDefaultsConfig_basic(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, "aws_ecs_cluster.test", &cluster),
+					resource.TestCheckResourceAttr(resourceName, names.AttrClusterName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrNamespace, namespace1ResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccClusterServiceConnectDefaultsConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, "aws_ecs_cluster.test", &cluster),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrNamespace, namespace2ResourceName, names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSClusterServiceConnectDefaults_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster ecs.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_cluster_service_connect_defaults.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterServiceConnectDefaultsConfig_basic(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, "aws_ecs_cluster.test", &cluster),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfecs.ResourceClusterServiceConnectDefaults(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccECSClusterServiceConnectDefaults_namespaceDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster ecs.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_cluster_service_connect_defaults.test"
+	namespace1ResourceName := "aws_service_discovery_http_namespace.test.0"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterServiceConnectDefaultsConfig_basic(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, "aws_ecs_cluster.test", &cluster),
+					testAccCheckClusterServiceConnectDefaultsNamespaceChanged(ctx, "aws_ecs_cluster.test", "aws_service_discovery_http_namespace.test.1"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccClusterServiceConnectDefaultsConfig_basic(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, "aws_ecs_cluster.test", &cluster),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrNamespace, namespace1ResourceName, names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckClusterServiceConnectDefaultsNamespaceChanged changes a cluster's default Service Connect namespace outside Terraform.
+func testAccCheckClusterServiceConnectDefaultsNamespaceChanged(ctx context.Context, clusterResourceName, namespaceResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[clusterResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", clusterResourceName)
+		}
+
+		ns, ok := s.RootModule().Resources[namespaceResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", namespaceResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn(ctx)
+
+		_, err := conn.UpdateClusterWithContext(ctx, &ecs.UpdateClusterInput{
+			Cluster: aws.String(rs.Primary.ID),
+			ServiceConnectDefaults: &ecs.ClusterServiceConnectDefaultsRequest{
+				Namespace: aws.String(ns.Primary.Attributes[names.AttrARN]),
+			},
+		})
+
+		return err
+	}
+}
+
+func testAccClusterServiceConnectDefaultsConfig_basic(rName string, idx int) string {
+	return fmt.Sprintf(`
+resource "aws_service_discovery_http_namespace" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+}
+
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [service_connect_defaults]
+  }
+}
+
+resource "aws_ecs_cluster_service_connect_defaults" "test" {
+  cluster_name = aws_ecs_cluster.test.name
+  namespace    = aws_service_discovery_http_namespace.test[%[2]d].arn
+}
+`, rName, idx)
+}
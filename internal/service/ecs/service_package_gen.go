@@ -80,6 +80,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceClusterCapacityProviders,
 			TypeName: "aws_ecs_cluster_capacity_providers",
 		},
+		{
+			Factory:  ResourceClusterServiceConnectDefaults,
+			TypeName: "aws_ecs_cluster_service_connect_defaults",
+			Name:     "Cluster Service Connect Defaults",
+		},
 		{
 			Factory:  ResourceService,
 			TypeName: "aws_ecs_service",
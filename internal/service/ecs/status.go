@@ -23,6 +23,15 @@ const (
 	taskSetStatusActive   = "ACTIVE"
 	taskSetStatusDraining = "DRAINING"
 	taskSetStatusPrimary  = "PRIMARY"
+
+	taskStatusActivating     = "ACTIVATING"
+	taskStatusDeactivating   = "DEACTIVATING"
+	taskStatusDeprovisioning = "DEPROVISIONING"
+	taskStatusPending        = "PENDING"
+	taskStatusProvisioning   = "PROVISIONING"
+	taskStatusRunning        = "RUNNING"
+	taskStatusStopped        = "STOPPED"
+	taskStatusStopping       = "STOPPING"
 )
 
 func statusCapacityProvider(ctx context.Context, conn *ecs.ECS, arn string) retry.StateRefreshFunc {
@@ -139,3 +148,32 @@ func statusTaskSet(ctx context.Context, conn *ecs.ECS, taskSetID, service, clust
 		return output.TaskSets[0], aws.StringValue(output.TaskSets[0].Status), nil
 	}
 }
+
+// statusTasks returns the last status of the first of the specified ECS Tasks that has not stopped,
+// or "STOPPED" once all the tasks have stopped.
+func statusTasks(ctx context.Context, conn *ecs.ECS, cluster string, taskARNs []*string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ecs.DescribeTasksInput{
+			Cluster: aws.String(cluster),
+			Tasks:   taskARNs,
+		}
+
+		output, err := findTasks(ctx, conn, input)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, v := range output {
+			if status := aws.StringValue(v.LastStatus); status != taskStatusStopped {
+				return output, status, nil
+			}
+		}
+
+		return output, taskStatusStopped, nil
+	}
+}
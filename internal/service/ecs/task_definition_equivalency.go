@@ -84,29 +84,69 @@ func (cd containerDefinitions) Reduce(isAWSVPC bool) error {
 			}
 		}
 
+		// Deal with health check fields which have defaults
+		if hc := def.HealthCheck; hc != nil {
+			if hc.Interval == nil {
+				hc.Interval = aws.Int64(30)
+			}
+			if hc.Retries == nil {
+				hc.Retries = aws.Int64(3)
+			}
+			if hc.Timeout == nil {
+				hc.Timeout = aws.Int64(5)
+			}
+			if hc.StartPeriod != nil && aws.Int64Value(hc.StartPeriod) == 0 {
+				hc.StartPeriod = nil
+			}
+		}
+
 		// Create a mutable copy
 		defCopy, err := copystructure.Copy(def)
 		if err != nil {
 			return err
 		}
 
+		// Set all empty slices, including those in nested blocks such as logConfiguration.secretOptions, to nil
 		definition := reflect.ValueOf(defCopy).Elem()
-		for i := 0; i < definition.NumField(); i++ {
-			sf := definition.Field(i)
+		setEmptySlicesToNil(definition)
 
-			// Set all empty slices to nil
-			if sf.Kind() == reflect.Slice {
-				if sf.IsValid() && !sf.IsNil() && sf.Len() == 0 {
-					sf.Set(reflect.Zero(sf.Type()))
-				}
-			}
-		}
 		iface := definition.Interface().(ecs.ContainerDefinition)
 		cd[i] = &iface
 	}
 	return nil
 }
 
+// setEmptySlicesToNil recursively sets all empty slices in the specified struct value to nil.
+func setEmptySlicesToNil(v reflect.Value) {
+	for i := 0; i < v.NumField(); i++ {
+		sf := v.Field(i)
+
+		if !sf.CanSet() {
+			continue
+		}
+
+		switch sf.Kind() {
+		case reflect.Slice:
+			if sf.IsNil() {
+				continue
+			}
+			if sf.Len() == 0 {
+				sf.Set(reflect.Zero(sf.Type()))
+				continue
+			}
+			for j := 0; j < sf.Len(); j++ {
+				if e := sf.Index(j); e.Kind() == reflect.Pointer && !e.IsNil() && e.Elem().Kind() == reflect.Struct {
+					setEmptySlicesToNil(e.Elem())
+				}
+			}
+		case reflect.Pointer:
+			if !sf.IsNil() && sf.Elem().Kind() == reflect.Struct {
+				setEmptySlicesToNil(sf.Elem())
+			}
+		}
+	}
+}
+
 func (cd containerDefinitions) OrderEnvironmentVariables() {
 	for _, def := range cd {
 		sort.Slice(def.Environment, func(i, j int) bool {
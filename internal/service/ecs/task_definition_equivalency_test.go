@@ -578,3 +578,102 @@ func TestContainerDefinitionsAreEquivalent_missingEnvironmentName(t *testing.T)
 		t.Fatal("Expected definitions to be equal.")
 	}
 }
+
+func TestContainerDefinitionsAreEquivalent_healthCheck(t *testing.T) {
+	t.Parallel()
+
+	cfgRepresention := `
+[
+  {
+    "name": "wordpress",
+    "image": "wordpress",
+    "memory": 500,
+    "healthCheck": {
+      "command": ["CMD-SHELL", "curl -f http://localhost/ || exit 1"]
+    }
+  }
+]
+`
+
+	apiRepresentation := `
+[
+  {
+    "name": "wordpress",
+    "image": "wordpress",
+    "memory": 500,
+    "essential": true,
+    "healthCheck": {
+      "command": ["CMD-SHELL", "curl -f http://localhost/ || exit 1"],
+      "interval": 30,
+      "retries": 3,
+      "timeout": 5
+    }
+  }
+]
+`
+
+	equal, err := tfecs.ContainerDefinitionsAreEquivalent(cfgRepresention, apiRepresentation, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equal {
+		t.Fatal("Expected definitions to be equal.")
+	}
+}
+
+func TestContainerDefinitionsAreEquivalent_nestedArrays(t *testing.T) {
+	t.Parallel()
+
+	cfgRepresention := `
+[
+  {
+    "name": "wordpress",
+    "image": "wordpress",
+    "memory": 500,
+    "logConfiguration": {
+      "logDriver": "awslogs",
+      "options": {
+        "awslogs-group": "wordpress"
+      }
+    },
+    "linuxParameters": {
+      "initProcessEnabled": true
+    }
+  }
+]
+`
+
+	apiRepresentation := `
+[
+  {
+    "name": "wordpress",
+    "image": "wordpress",
+    "memory": 500,
+    "essential": true,
+    "logConfiguration": {
+      "logDriver": "awslogs",
+      "options": {
+        "awslogs-group": "wordpress"
+      },
+      "secretOptions": []
+    },
+    "linuxParameters": {
+      "initProcessEnabled": true,
+      "devices": [],
+      "tmpfs": []
+    },
+    "mountPoints": [],
+    "volumesFrom": [],
+    "systemControls": []
+  }
+]
+`
+
+	equal, err := tfecs.ContainerDefinitionsAreEquivalent(cfgRepresention, apiRepresentation, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equal {
+		t.Fatal("Expected definitions to be equal.")
+	}
+}
@@ -6,6 +6,7 @@ package ecs
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
@@ -25,6 +26,10 @@ func DataSourceTaskExecution() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceTaskExecutionRead,
 
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrCapacityProviderStrategy: {
 				Type:     schema.TypeSet,
@@ -269,6 +274,54 @@ func DataSourceTaskExecution() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"tasks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"containers": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exit_code": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"reason": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"last_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stop_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stopped_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"task_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -364,9 +417,55 @@ func dataSourceTaskExecutionRead(ctx context.Context, d *schema.ResourceData, me
 	}
 	d.Set("task_arns", flex.FlattenStringList(taskArns))
 
+	tasks := out.Tasks
+	if d.Get("wait_for_completion").(bool) {
+		tasks, err = waitTasksStopped(ctx, conn, cluster, taskArns, d.Timeout(schema.TimeoutRead))
+		if err != nil {
+			return create.AppendDiagError(diags, names.ECS, create.ErrActionWaitingForCreation, DSNameTaskExecution, d.Id(), err)
+		}
+	}
+
+	if err := d.Set("tasks", flattenTaskExecutionTasks(tasks)); err != nil {
+		return create.AppendDiagError(diags, names.ECS, create.ErrActionSetting, DSNameTaskExecution, d.Id(), err)
+	}
+
 	return diags
 }
 
+func flattenTaskExecutionTasks(apiObjects []*ecs.Task) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		containers := make([]interface{}, 0, len(apiObject.Containers))
+		for _, v := range apiObject.Containers {
+			tfMap := map[string]interface{}{
+				names.AttrName: aws.StringValue(v.Name),
+				"reason":       aws.StringValue(v.Reason),
+			}
+
+			if v := v.ExitCode; v != nil {
+				tfMap["exit_code"] = aws.Int64Value(v)
+			}
+
+			containers = append(containers, tfMap)
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"containers":     containers,
+			"last_status":    aws.StringValue(apiObject.LastStatus),
+			"stop_code":      aws.StringValue(apiObject.StopCode),
+			"stopped_reason": aws.StringValue(apiObject.StoppedReason),
+			"task_arn":       aws.StringValue(apiObject.TaskArn),
+		})
+	}
+
+	return tfList
+}
+
 func expandTaskOverride(tfList []interface{}) *ecs.TaskOverride {
 	if len(tfList) == 0 {
 		return nil
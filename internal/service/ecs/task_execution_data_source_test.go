@@ -124,6 +124,40 @@ func TestAccECSTaskExecutionDataSource_tags(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskExecutionDataSource_waitForCompletion(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ecs_task_execution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, ecs.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskExecutionDataSourceConfig_waitForCompletion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "task_arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tasks.0.task_arn", dataSourceName, "task_arns.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.0.last_status", "STOPPED"),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.0.stop_code", "EssentialContainerExited"),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.0.containers.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.0.containers.0.name", "sleep"),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.0.containers.0.exit_code", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func testAccTaskExecutionDataSourceConfig_base(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_security_group" "test" {
@@ -261,3 +295,26 @@ data "aws_ecs_task_execution" "test" {
 }
 `, envKey1, envValue1))
 }
+
+func testAccTaskExecutionDataSourceConfig_waitForCompletion(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigVPCWithSubnets(rName, 2),
+		testAccTaskExecutionDataSourceConfig_base(rName),
+		`
+data "aws_ecs_task_execution" "test" {
+  depends_on = [aws_ecs_cluster_capacity_providers.test]
+
+  cluster             = aws_ecs_cluster.test.id
+  task_definition     = aws_ecs_task_definition.test.arn
+  desired_count       = 1
+  launch_type         = "FARGATE"
+  wait_for_completion = true
+
+  network_configuration {
+    subnets          = aws_subnet.test[*].id
+    security_groups  = [aws_security_group.test.id]
+    assign_public_ip = false
+  }
+}
+`)
+}
@@ -29,6 +29,8 @@ const (
 
 	taskSetCreateTimeout = 10 * time.Minute
 	taskSetDeleteTimeout = 10 * time.Minute
+
+	tasksStoppedDelay = 10 * time.Second
 )
 
 func waitCapacityProviderDeleted(ctx context.Context, conn *ecs.ECS, arn string) (*ecs.CapacityProvider, error) {
@@ -157,3 +159,30 @@ func waitTaskSetDeleted(ctx context.Context, conn *ecs.ECS, taskSetID, service,
 
 	return err
 }
+
+// waitTasksStopped waits for all the specified ECS Tasks to reach the status "STOPPED".
+func waitTasksStopped(ctx context.Context, conn *ecs.ECS, cluster string, taskARNs []*string, timeout time.Duration) ([]*ecs.Task, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			taskStatusActivating,
+			taskStatusDeactivating,
+			taskStatusDeprovisioning,
+			taskStatusPending,
+			taskStatusProvisioning,
+			taskStatusRunning,
+			taskStatusStopping,
+		},
+		Target:  []string{taskStatusStopped},
+		Refresh: statusTasks(ctx, conn, cluster, taskARNs),
+		Timeout: timeout,
+		Delay:   tasksStoppedDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if v, ok := outputRaw.([]*ecs.Task); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -37,6 +38,8 @@ func resourceAccessPolicyAssociation() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: validateAccessScope,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -56,13 +59,15 @@ func resourceAccessPolicyAssociation() *schema.Resource {
 							Optional: true,
 							ForceNew: true,
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
+								Type:         schema.TypeString,
+								ValidateFunc: validAccessScopeNamespace,
 							},
 						},
 						names.AttrType: {
-							Type:     schema.TypeString,
-							ForceNew: true,
-							Required: true,
+							Type:             schema.TypeString,
+							ForceNew:         true,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.AccessScopeType](),
 						},
 					},
 				},
@@ -183,6 +188,27 @@ func resourceAccessPolicyAssociationDelete(ctx context.Context, d *schema.Resour
 	return diags
 }
 
+func validateAccessScope(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("access_scope.0.namespaces") {
+		return nil
+	}
+
+	namespaces := d.Get("access_scope.0.namespaces").(*schema.Set).Len()
+
+	switch v := d.Get("access_scope.0.type").(string); types.AccessScopeType(v) {
+	case types.AccessScopeTypeCluster:
+		if namespaces > 0 {
+			return fmt.Errorf(`"access_scope.0.namespaces" must not be set when "access_scope.0.type" is %q`, v)
+		}
+	case types.AccessScopeTypeNamespace:
+		if namespaces == 0 {
+			return fmt.Errorf(`"access_scope.0.namespaces" must be set when "access_scope.0.type" is %q`, v)
+		}
+	}
+
+	return nil
+}
+
 const accessPolicyAssociationResourceIDSeparator = "#"
 
 func accessPolicyAssociationCreateResourceID(clusterName, principalARN, policyARN string) string {
@@ -49,6 +49,7 @@ func resourceCluster() *schema.Resource {
 				// You cannot disable envelope encryption after enabling it. This action is irreversible.
 				return len(old.([]interface{})) == 1 && len(new.([]interface{})) == 0
 			}),
+			validateClusterBootstrapAccessEntries,
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -71,6 +72,24 @@ func resourceCluster() *schema.Resource {
 							Computed:         true,
 							ValidateDiagFunc: enum.Validate[types.AuthenticationMode](),
 						},
+						"bootstrap_access_entry": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"policy_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"principal_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
 						"bootstrap_cluster_creator_admin_permissions": {
 							Type:     schema.TypeBool,
 							Optional: true,
@@ -388,6 +407,14 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "waiting for EKS Cluster (%s) create: %s", d.Id(), err)
 	}
 
+	// Grant cluster access as part of cluster creation, so that a cluster created without
+	// cluster creator admin permissions is never left without an administrator.
+	if v, ok := d.GetOk("access_config.0.bootstrap_access_entry"); ok {
+		if err := createClusterBootstrapAccessEntries(ctx, conn, d.Id(), v.([]interface{})); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EKS Cluster (%s) bootstrap access entries: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceClusterRead(ctx, d, meta)...)
 }
 
@@ -407,14 +434,20 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "reading EKS Cluster (%s): %s", d.Id(), err)
 	}
 
-	// bootstrap_cluster_creator_admin_permissions isn't returned from the AWS API.
+	// bootstrap_access_entry and bootstrap_cluster_creator_admin_permissions aren't returned from the AWS API.
 	var bootstrapClusterCreatorAdminPermissions *bool
+	var bootstrapAccessEntries []interface{}
 	if v, ok := d.GetOk("access_config"); ok {
 		if apiObject := expandCreateAccessConfigRequest(v.([]interface{})); apiObject != nil {
 			bootstrapClusterCreatorAdminPermissions = apiObject.BootstrapClusterCreatorAdminPermissions
 		}
+		bootstrapAccessEntries = d.Get("access_config.0.bootstrap_access_entry").([]interface{})
+	}
+	tfList := flattenAccessConfigResponse(cluster.AccessConfig, bootstrapClusterCreatorAdminPermissions)
+	if len(tfList) > 0 && len(bootstrapAccessEntries) > 0 {
+		tfList[0].(map[string]interface{})["bootstrap_access_entry"] = bootstrapAccessEntries
 	}
-	if err := d.Set("access_config", flattenAccessConfigResponse(cluster.AccessConfig, bootstrapClusterCreatorAdminPermissions)); err != nil {
+	if err := d.Set("access_config", tfList); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting access_config: %s", err)
 	}
 	d.Set(names.AttrARN, cluster.Arn)
@@ -481,7 +514,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
-	if d.HasChange("access_config") {
+	if d.HasChange("access_config.0.authentication_mode") {
 		if v, ok := d.GetOk("access_config"); ok {
 			input := &eks.UpdateClusterConfigInput{
 				AccessConfig: expandUpdateAccessConfigRequest(v.([]interface{})),
@@ -504,6 +537,16 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	// Bootstrap access entries that are missing, e.g. after import, are created.
+	// Access entries that are no longer configured are left in place.
+	if d.HasChange("access_config.0.bootstrap_access_entry") {
+		if v, ok := d.GetOk("access_config.0.bootstrap_access_entry"); ok {
+			if err := createClusterBootstrapAccessEntries(ctx, conn, d.Id(), v.([]interface{})); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EKS Cluster (%s) bootstrap access entries: %s", d.Id(), err)
+			}
+		}
+	}
+
 	if d.HasChange("encryption_config") {
 		o, n := d.GetChange("encryption_config")
 
@@ -802,6 +845,97 @@ func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.Client, name, id
 	return nil, err
 }
 
+func validateClusterBootstrapAccessEntries(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if v := d.Get("access_config.0.bootstrap_access_entry").([]interface{}); len(v) == 0 {
+		return nil
+	}
+
+	// Access entries can't be created for clusters that only use the aws-auth ConfigMap.
+	if v := d.Get("access_config.0.authentication_mode").(string); v == string(types.AuthenticationModeConfigMap) {
+		return fmt.Errorf(`"access_config.0.bootstrap_access_entry" requires "access_config.0.authentication_mode" to be %q or %q`, types.AuthenticationModeApi, types.AuthenticationModeApiAndConfigMap)
+	}
+
+	return nil
+}
+
+func createClusterBootstrapAccessEntries(ctx context.Context, conn *eks.Client, clusterName string, tfList []interface{}) error {
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if err := createClusterBootstrapAccessEntry(ctx, conn, clusterName, tfMap["principal_arn"].(string), tfMap["policy_arn"].(string)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createClusterBootstrapAccessEntry creates a standard access entry for the specified principal and,
+// if a policy is specified, associates the policy with it for the whole cluster.
+// An existing access entry or access policy association is left unchanged.
+func createClusterBootstrapAccessEntry(ctx context.Context, conn *eks.Client, clusterName, principalARN, policyARN string) error {
+	id := accessEntryCreateResourceID(clusterName, principalARN)
+
+	_, err := findAccessEntryByTwoPartKey(ctx, conn, clusterName, principalARN)
+
+	switch {
+	case tfresource.NotFound(err):
+		input := &eks.CreateAccessEntryInput{
+			ClusterName:  aws.String(clusterName),
+			PrincipalArn: aws.String(principalARN),
+			Type:         aws.String(accessEntryTypeStandard),
+		}
+
+		_, err := tfresource.RetryWhenIsAErrorMessageContains[*types.InvalidParameterException](ctx, propagationTimeout, func() (interface{}, error) {
+			return conn.CreateAccessEntry(ctx, input)
+		}, "The specified principalArn is invalid: invalid principal")
+
+		if err != nil {
+			return fmt.Errorf("creating EKS Access Entry (%s): %w", id, err)
+		}
+	case err != nil:
+		return fmt.Errorf("reading EKS Access Entry (%s): %w", id, err)
+	}
+
+	if policyARN == "" {
+		return nil
+	}
+
+	id = accessPolicyAssociationCreateResourceID(clusterName, principalARN, policyARN)
+
+	_, err = findAccessPolicyAssociationByThreePartKey(ctx, conn, clusterName, principalARN, policyARN)
+
+	if err == nil {
+		return nil
+	}
+
+	if !tfresource.NotFound(err) {
+		return fmt.Errorf("reading EKS Access Policy Association (%s): %w", id, err)
+	}
+
+	associateInput := &eks.AssociateAccessPolicyInput{
+		AccessScope: &types.AccessScope{
+			Type: types.AccessScopeTypeCluster,
+		},
+		ClusterName:  aws.String(clusterName),
+		PolicyArn:    aws.String(policyARN),
+		PrincipalArn: aws.String(principalARN),
+	}
+
+	_, err = tfresource.RetryWhenIsAErrorMessageContains[*types.ResourceNotFoundException](ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.AssociateAccessPolicy(ctx, associateInput)
+	}, "The specified principalArn could not be found")
+
+	if err != nil {
+		return fmt.Errorf("creating EKS Access Policy Association (%s): %w", id, err)
+	}
+
+	return nil
+}
+
 func expandCreateAccessConfigRequest(tfList []interface{}) *types.CreateAccessConfigRequest {
 	if len(tfList) == 0 {
 		return nil
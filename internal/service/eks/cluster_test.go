@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -138,6 +139,72 @@ func TestAccEKSCluster_AccessConfig_create(t *testing.T) {
 	})
 }
 
+func TestAccEKSCluster_AccessConfig_bootstrapAccessEntry(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster1, cluster2 types.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_accessConfigBootstrapAccessEntry(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster1),
+					resource.TestCheckResourceAttr(resourceName, "access_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "access_config.0.authentication_mode", string(types.AuthenticationModeApi)),
+					resource.TestCheckResourceAttr(resourceName, "access_config.0.bootstrap_cluster_creator_admin_permissions", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "access_config.0.bootstrap_access_entry.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "access_config.0.bootstrap_access_entry.0.principal_arn", "aws_iam_role.test", names.AttrARN),
+					testAccCheckClusterBootstrapAccessEntryExists(ctx, resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"access_config.0.bootstrap_access_entry",
+					"access_config.0.bootstrap_cluster_creator_admin_permissions",
+				},
+			},
+			{
+				// Adding a bootstrap access entry creates it without replacing the cluster.
+				Config: testAccClusterConfig_accessConfigBootstrapAccessEntryAdded(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster2),
+					testAccCheckClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "access_config.0.bootstrap_access_entry.#", acctest.Ct2),
+					resource.TestCheckResourceAttrPair(resourceName, "access_config.0.bootstrap_access_entry.1.principal_arn", "aws_iam_role.test2", names.AttrARN),
+					testAccCheckClusterBootstrapAccessEntryExists(ctx, resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSCluster_AccessConfig_bootstrapAccessEntryConfigMap(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterConfig_accessConfigBootstrapAccessEntryMode(rName, types.AuthenticationModeConfigMap),
+				ExpectError: regexache.MustCompile(`"access_config.0.bootstrap_access_entry" requires "access_config.0.authentication_mode" to be "API" or "API_AND_CONFIG_MAP"`),
+			},
+		},
+	})
+}
+
 func TestAccEKSCluster_AccessConfig_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	var cluster types.Cluster
@@ -826,6 +893,41 @@ func testAccCheckClusterExists(ctx context.Context, n string, v *types.Cluster)
 	}
 }
 
+func testAccCheckClusterBootstrapAccessEntryExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSClient(ctx)
+
+		n, err := strconv.Atoi(rs.Primary.Attributes["access_config.0.bootstrap_access_entry.#"])
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < n; i++ {
+			principalARN := rs.Primary.Attributes[fmt.Sprintf("access_config.0.bootstrap_access_entry.%d.principal_arn", i)]
+			policyARN := rs.Primary.Attributes[fmt.Sprintf("access_config.0.bootstrap_access_entry.%d.policy_arn", i)]
+
+			if _, err := tfeks.FindAccessEntryByTwoPartKey(ctx, conn, rs.Primary.ID, principalARN); err != nil {
+				return err
+			}
+
+			if policyARN == "" {
+				continue
+			}
+
+			if _, err := tfeks.FindAccessPolicyAssociationByThreePartKey(ctx, conn, rs.Primary.ID, principalARN, policyARN); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckClusterDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -980,6 +1082,83 @@ resource "aws_eks_cluster" "test" {
 `, rName, authenticationMode))
 }
 
+func testAccClusterConfig_accessConfigBootstrapAccessEntry(rName string) string {
+	return testAccClusterConfig_accessConfigBootstrapAccessEntryMode(rName, types.AuthenticationModeApi)
+}
+
+func testAccClusterConfig_accessConfigBootstrapAccessEntryMode(rName string, authenticationMode types.AuthenticationMode) string {
+	return acctest.ConfigCompose(testAccClusterConfig_base(rName), fmt.Sprintf(`
+resource "aws_eks_cluster" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  access_config {
+    authentication_mode                         = %[2]q
+    bootstrap_cluster_creator_admin_permissions = false
+
+    bootstrap_access_entry {
+      principal_arn = aws_iam_role.test.arn
+      policy_arn    = "arn:${data.aws_partition.current.partition}:eks::aws:cluster-access-policy/AmazonEKSClusterAdminPolicy"
+    }
+  }
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test-AmazonEKSClusterPolicy]
+}
+`, rName, authenticationMode))
+}
+
+func testAccClusterConfig_accessConfigBootstrapAccessEntryAdded(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_role" "test2" {
+  name = "%[1]s-2"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "eks.${data.aws_partition.current.dns_suffix}"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_eks_cluster" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  access_config {
+    authentication_mode                         = "API"
+    bootstrap_cluster_creator_admin_permissions = false
+
+    bootstrap_access_entry {
+      principal_arn = aws_iam_role.test.arn
+      policy_arn    = "arn:${data.aws_partition.current.partition}:eks::aws:cluster-access-policy/AmazonEKSClusterAdminPolicy"
+    }
+
+    bootstrap_access_entry {
+      principal_arn = aws_iam_role.test2.arn
+    }
+  }
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test-AmazonEKSClusterPolicy]
+}
+`, rName))
+}
+
 func testAccClusterConfig_version(rName, version string) string {
 	return acctest.ConfigCompose(testAccClusterConfig_base(rName), fmt.Sprintf(`
 resource "aws_eks_cluster" "test" {
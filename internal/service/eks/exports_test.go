@@ -13,6 +13,7 @@ var (
 	ResourceIdentityProviderConfig  = resourceIdentityProviderConfig
 	ResourceNodeGroup               = resourceNodeGroup
 	ResourcePodIdentityAssociation  = newPodIdentityAssociationResource
+	ResourcePodIdentityAssociations = resourcePodIdentityAssociations
 
 	FindAccessEntryByTwoPartKey                = findAccessEntryByTwoPartKey
 	FindAccessPolicyAssociationByThreePartKey  = findAccessPolicyAssociationByThreePartKey
@@ -22,4 +23,5 @@ var (
 	FindNodegroupByTwoPartKey                  = findNodegroupByTwoPartKey
 	FindOIDCIdentityProviderConfigByTwoPartKey = findOIDCIdentityProviderConfigByTwoPartKey
 	FindPodIdentityAssociationByTwoPartKey     = findPodIdentityAssociationByTwoPartKey
+	FindPodIdentityAssociationsByClusterName   = findPodIdentityAssociationsByClusterName
 )
@@ -445,6 +445,10 @@ func resourceNodeGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 
 	setTagsOut(ctx, nodeGroup.Tags)
 
+	if health := nodeGroup.Health; health != nil && len(health.Issues) > 0 {
+		diags = sdkdiag.AppendWarningf(diags, "EKS Node Group (%s) has health issues: %s", d.Id(), issuesError(health.Issues))
+	}
+
 	return diags
 }
 
@@ -498,13 +502,30 @@ func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		output, err := conn.UpdateNodegroupVersion(ctx, input)
 
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating EKS Node Group (%s) version: %s", d.Id(), err)
+			diags = sdkdiag.AppendErrorf(diags, "updating EKS Node Group (%s) version: %s", d.Id(), err)
+			// Refresh the state so that it records the node group's actual configuration and the failed changes are planned again.
+			return append(diags, resourceNodeGroupRead(ctx, d, meta)...)
 		}
 
 		updateID := aws.ToString(output.Update.Id)
 
-		if _, err := waitNodegroupUpdateSuccessful(ctx, conn, clusterName, nodeGroupName, updateID, d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for EKS Node Group (%s) version update (%s): %s", d.Id(), updateID, err)
+		if update, err := waitNodegroupUpdateSuccessful(ctx, conn, clusterName, nodeGroupName, updateID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "waiting for EKS Node Group (%s) version update (%s): %s", d.Id(), updateID, err)
+			diags = append(diags, resourceNodeGroupRead(ctx, d, meta)...)
+
+			// A failed or cancelled version update leaves the node group on its previous version and release version.
+			// An update that is still in progress, e.g. after a timeout, hasn't been rolled back.
+			if update == nil || (update.Status != types.UpdateStatusFailed && update.Status != types.UpdateStatusCancelled) {
+				return diags
+			}
+
+			if v := aws.ToString(input.Version); v != "" && v != d.Get(names.AttrVersion).(string) {
+				diags = sdkdiag.AppendWarningf(diags, "EKS Node Group (%s) version update (%s) was rolled back to version %s", d.Id(), updateID, d.Get(names.AttrVersion).(string))
+			} else if v := aws.ToString(input.ReleaseVersion); v != "" && v != d.Get("release_version").(string) {
+				diags = sdkdiag.AppendWarningf(diags, "EKS Node Group (%s) version update (%s) was rolled back to release version %s", d.Id(), updateID, d.Get("release_version").(string))
+			}
+
+			return diags
 		}
 	}
 
@@ -535,13 +556,15 @@ func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		output, err := conn.UpdateNodegroupConfig(ctx, input)
 
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating EKS Node Group (%s) config: %s", d.Id(), err)
+			diags = sdkdiag.AppendErrorf(diags, "updating EKS Node Group (%s) config: %s", d.Id(), err)
+			return append(diags, resourceNodeGroupRead(ctx, d, meta)...)
 		}
 
 		updateID := aws.ToString(output.Update.Id)
 
 		if _, err := waitNodegroupUpdateSuccessful(ctx, conn, clusterName, nodeGroupName, updateID, d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for EKS Node Group (%s) config update (%s): %s", d.Id(), updateID, err)
+			diags = sdkdiag.AppendErrorf(diags, "waiting for EKS Node Group (%s) config update (%s): %s", d.Id(), updateID, err)
+			return append(diags, resourceNodeGroupRead(ctx, d, meta)...)
 		}
 	}
 
@@ -706,7 +729,7 @@ func waitNodegroupDeleted(ctx context.Context, conn *eks.Client, clusterName, no
 	return nil, err
 }
 
-func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.Client, clusterName, nodeGroupName, id string, timeout time.Duration) (*types.Update, error) { //nolint:unparam
+func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.Client, clusterName, nodeGroupName, id string, timeout time.Duration) (*types.Update, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(types.UpdateStatusInProgress),
 		Target:  enum.Slice(types.UpdateStatusSuccessful),
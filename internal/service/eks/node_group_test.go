@@ -702,6 +702,38 @@ func TestAccEKSNodeGroup_Scaling_maxSize(t *testing.T) {
 	})
 }
 
+func TestAccEKSNodeGroup_Scaling_failedUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var nodeGroup types.Nodegroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_node_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNodeGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNodeGroupConfig_scalingSizes(rName, 1, 1, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNodeGroupExists(ctx, resourceName, &nodeGroup),
+				),
+			},
+			{
+				// EKS rejects a desired size greater than the maximum size.
+				Config:      testAccNodeGroupConfig_scalingSizes(rName, 2, 1, 1),
+				ExpectError: regexache.MustCompile(`updating EKS Node Group \(.+\) config`),
+			},
+			{
+				// The failed update must not have been recorded in state.
+				Config:   testAccNodeGroupConfig_scalingSizes(rName, 1, 1, 1),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccEKSNodeGroup_Scaling_minSize(t *testing.T) {
 	ctx := acctest.Context(t)
 	var nodeGroup1, nodeGroup2 types.Nodegroup
@@ -0,0 +1,329 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_eks_pod_identity_associations", name="Pod Identity Associations")
+func resourcePodIdentityAssociations() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePodIdentityAssociationsCreate,
+		ReadWithoutTimeout:   resourcePodIdentityAssociationsRead,
+		UpdateWithoutTimeout: resourcePodIdentityAssociationsUpdate,
+		DeleteWithoutTimeout: resourcePodIdentityAssociationsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"association": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"association_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrAssociationID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrNamespace: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrRoleARN: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"service_account": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				Set: podIdentityAssociationHash,
+			},
+			names.AttrClusterName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validClusterName,
+			},
+		},
+	}
+}
+
+func resourcePodIdentityAssociationsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName := d.Get(names.AttrClusterName).(string)
+	associations, err := findPodIdentityAssociationsByClusterName(ctx, conn, clusterName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Pod Identity Associations (%s): %s", clusterName, err)
+	}
+
+	d.SetId(clusterName)
+
+	if err := podIdentityAssociationsReconcile(ctx, conn, clusterName, associations, d.Get("association").(*schema.Set).List()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EKS Pod Identity Associations (%s): %s", clusterName, err)
+	}
+
+	return append(diags, resourcePodIdentityAssociationsRead(ctx, d, meta)...)
+}
+
+func resourcePodIdentityAssociationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	associations, err := findPodIdentityAssociationsByClusterName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EKS Pod Identity Associations (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Pod Identity Associations (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("association", flattenPodIdentityAssociations(associations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting association: %s", err)
+	}
+	d.Set(names.AttrClusterName, d.Id())
+
+	return diags
+}
+
+func resourcePodIdentityAssociationsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	if d.HasChange("association") {
+		// List the cluster's associations, as those created by aws_eks_pod_identity_association or the console aren't in state.
+		associations, err := findPodIdentityAssociationsByClusterName(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EKS Pod Identity Associations (%s): %s", d.Id(), err)
+		}
+
+		if err := podIdentityAssociationsReconcile(ctx, conn, d.Id(), associations, d.Get("association").(*schema.Set).List()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EKS Pod Identity Associations (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePodIdentityAssociationsRead(ctx, d, meta)...)
+}
+
+func resourcePodIdentityAssociationsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	log.Printf("[DEBUG] Deleting EKS Pod Identity Associations: %s", d.Id())
+	for _, v := range d.Get("association").(*schema.Set).List() {
+		associationID := v.(map[string]interface{})[names.AttrAssociationID].(string)
+
+		if err := deletePodIdentityAssociation(ctx, conn, d.Id(), associationID); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting EKS Pod Identity Associations (%s): %s", d.Id(), err)
+		}
+	}
+
+	return diags
+}
+
+// podIdentityAssociationsReconcile creates, updates and deletes pod identity associations in the specified cluster
+// so that the associations in `from` become the associations in `to`.
+// Associations are matched by namespace and service account.
+func podIdentityAssociationsReconcile(ctx context.Context, conn *eks.Client, clusterName string, from []types.PodIdentityAssociation, to []interface{}) error {
+	fromByKey := make(map[string]types.PodIdentityAssociation)
+
+	for _, v := range from {
+		fromByKey[podIdentityAssociationKey(aws.ToString(v.Namespace), aws.ToString(v.ServiceAccount))] = v
+	}
+
+	toByKey := make(map[string]map[string]interface{})
+
+	for _, v := range to {
+		tfMap := v.(map[string]interface{})
+		key := podIdentityAssociationKey(tfMap[names.AttrNamespace].(string), tfMap["service_account"].(string))
+
+		if _, ok := toByKey[key]; ok {
+			return fmt.Errorf("duplicate association for service account %s", key)
+		}
+
+		toByKey[key] = tfMap
+	}
+
+	// Delete first so that service accounts moving between associations do not conflict.
+	for key, association := range fromByKey {
+		if _, ok := toByKey[key]; ok {
+			continue
+		}
+
+		if err := deletePodIdentityAssociation(ctx, conn, clusterName, aws.ToString(association.AssociationId)); err != nil {
+			return err
+		}
+	}
+
+	for key, tfMap := range toByKey {
+		roleARN := tfMap[names.AttrRoleARN].(string)
+
+		if association, ok := fromByKey[key]; ok {
+			if aws.ToString(association.RoleArn) == roleARN {
+				continue
+			}
+
+			input := &eks.UpdatePodIdentityAssociationInput{
+				AssociationId:      association.AssociationId,
+				ClientRequestToken: aws.String(sdkid.UniqueId()),
+				ClusterName:        aws.String(clusterName),
+				RoleArn:            aws.String(roleARN),
+			}
+
+			_, err := tfresource.RetryWhenIsAErrorMessageContains[*types.InvalidParameterException](ctx, propagationTimeout, func() (interface{}, error) {
+				return conn.UpdatePodIdentityAssociation(ctx, input)
+			}, "Role provided in the request does not exist")
+
+			if err != nil {
+				return fmt.Errorf("updating Pod Identity Association (%s): %w", aws.ToString(association.AssociationId), err)
+			}
+
+			continue
+		}
+
+		input := &eks.CreatePodIdentityAssociationInput{
+			ClientRequestToken: aws.String(sdkid.UniqueId()),
+			ClusterName:        aws.String(clusterName),
+			Namespace:          aws.String(tfMap[names.AttrNamespace].(string)),
+			RoleArn:            aws.String(roleARN),
+			ServiceAccount:     aws.String(tfMap["service_account"].(string)),
+		}
+
+		_, err := tfresource.RetryWhenIsAErrorMessageContains[*types.InvalidParameterException](ctx, propagationTimeout, func() (interface{}, error) {
+			return conn.CreatePodIdentityAssociation(ctx, input)
+		}, "Role provided in the request does not exist")
+
+		if err != nil {
+			return fmt.Errorf("creating Pod Identity Association (%s): %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+func deletePodIdentityAssociation(ctx context.Context, conn *eks.Client, clusterName, associationID string) error {
+	_, err := conn.DeletePodIdentityAssociation(ctx, &eks.DeletePodIdentityAssociationInput{
+		AssociationId: aws.String(associationID),
+		ClusterName:   aws.String(clusterName),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting Pod Identity Association (%s): %w", associationID, err)
+	}
+
+	return nil
+}
+
+func podIdentityAssociationKey(namespace, serviceAccount string) string {
+	return namespace + "/" + serviceAccount
+}
+
+func podIdentityAssociationHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	tfMap := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", tfMap[names.AttrNamespace].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", tfMap["service_account"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", tfMap[names.AttrRoleARN].(string)))
+
+	return create.StringHashcode(buf.String())
+}
+
+// findPodIdentityAssociationsByClusterName returns all the pod identity associations in the specified cluster.
+// The summaries returned by ListPodIdentityAssociations do not include the IAM role, so each association is described.
+func findPodIdentityAssociationsByClusterName(ctx context.Context, conn *eks.Client, clusterName string) ([]types.PodIdentityAssociation, error) {
+	input := &eks.ListPodIdentityAssociationsInput{
+		ClusterName: aws.String(clusterName),
+		MaxResults:  aws.Int32(100),
+	}
+	var output []types.PodIdentityAssociation
+
+	pages := eks.NewListPodIdentityAssociationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Associations {
+			association, err := findPodIdentityAssociationByTwoPartKey(ctx, conn, aws.ToString(v.AssociationId), clusterName)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			output = append(output, *association)
+		}
+	}
+
+	return output, nil
+}
+
+func flattenPodIdentityAssociations(apiObjects []types.PodIdentityAssociation) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"association_arn":       aws.ToString(apiObject.AssociationArn),
+			names.AttrAssociationID: aws.ToString(apiObject.AssociationId),
+			names.AttrNamespace:     aws.ToString(apiObject.Namespace),
+			names.AttrRoleARN:       aws.ToString(apiObject.RoleArn),
+			"service_account":       aws.ToString(apiObject.ServiceAccount),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,289 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSPodIdentityAssociations_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_pod_identity_associations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EKSEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPodIdentityAssociationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPodIdentityAssociationsConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPodIdentityAssociationsCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "association.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "association.*", map[string]string{
+						names.AttrNamespace: rName,
+						"service_account":   "sa1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "association.*", map[string]string{
+						names.AttrNamespace: rName,
+						"service_account":   "sa2",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "association.*.role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrClusterName, "aws_eks_cluster.test", names.AttrName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPodIdentityAssociationsConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPodIdentityAssociationsCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "association.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "association.*", map[string]string{
+						names.AttrNamespace: rName,
+						"service_account":   "sa2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "association.*", map[string]string{
+						names.AttrNamespace: rName,
+						"service_account":   "sa3",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "association.*.role_arn", "aws_iam_role.test2", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSPodIdentityAssociations_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_pod_identity_associations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EKSEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPodIdentityAssociationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPodIdentityAssociationsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPodIdentityAssociationsCount(ctx, resourceName, 2),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfeks.ResourcePodIdentityAssociations(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEKSPodIdentityAssociations_outOfBandAssociation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_pod_identity_associations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EKSEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPodIdentityAssociationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPodIdentityAssociationsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPodIdentityAssociationsCount(ctx, resourceName, 2),
+					testAccCheckPodIdentityAssociationsCreateOutOfBand(ctx, resourceName, rName, "sa-out-of-band"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccPodIdentityAssociationsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPodIdentityAssociationsCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "association.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPodIdentityAssociationsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_eks_pod_identity_associations" {
+				continue
+			}
+
+			output, err := tfeks.FindPodIdentityAssociationsByClusterName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("EKS Pod Identity Associations %s still exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPodIdentityAssociationsCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSClient(ctx)
+
+		output, err := tfeks.FindPodIdentityAssociationsByClusterName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("EKS Pod Identity Associations (%s) count = %d, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPodIdentityAssociationsCreateOutOfBand(ctx context.Context, n, namespace, serviceAccount string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		role, ok := s.RootModule().Resources["aws_iam_role.test"]
+		if !ok {
+			return fmt.Errorf("Not found: %s", "aws_iam_role.test")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSClient(ctx)
+
+		_, err := conn.CreatePodIdentityAssociation(ctx, &eks.CreatePodIdentityAssociationInput{
+			ClusterName:    aws.String(rs.Primary.ID),
+			Namespace:      aws.String(namespace),
+			RoleArn:        aws.String(role.Primary.Attributes[names.AttrARN]),
+			ServiceAccount: aws.String(serviceAccount),
+		})
+
+		return err
+	}
+}
+
+func testAccPodIdentityAssociationsConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccPodIdentityAssociationConfig_clusterBase(rName),
+		testAccPodIdentityAssociationConfig_podIdentityRoleBase(rName),
+		fmt.Sprintf(`
+resource "aws_eks_pod_identity_associations" "test" {
+  cluster_name = aws_eks_cluster.test.name
+
+  association {
+    namespace       = %[1]q
+    service_account = "sa1"
+    role_arn        = aws_iam_role.test.arn
+  }
+
+  association {
+    namespace       = %[1]q
+    service_account = "sa2"
+    role_arn        = aws_iam_role.test.arn
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
+
+func testAccPodIdentityAssociationsConfig_updated(rName string) string {
+	return acctest.ConfigCompose(
+		testAccPodIdentityAssociationConfig_clusterBase(rName),
+		testAccPodIdentityAssociationConfig_podIdentityRoleBase(rName),
+		fmt.Sprintf(`
+resource "aws_iam_role" "test2" {
+  name = "%[1]s-2"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "pods.eks.amazonaws.com"
+      },
+      "Action": [
+        "sts:AssumeRole",
+        "sts:TagSession"
+      ]
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_eks_pod_identity_associations" "test" {
+  cluster_name = aws_eks_cluster.test.name
+
+  association {
+    namespace       = %[1]q
+    service_account = "sa2"
+    role_arn        = aws_iam_role.test2.arn
+  }
+
+  association {
+    namespace       = %[1]q
+    service_account = "sa3"
+    role_arn        = aws_iam_role.test.arn
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
@@ -123,6 +123,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourcePodIdentityAssociations,
+			TypeName: "aws_eks_pod_identity_associations",
+			Name:     "Pod Identity Associations",
+		},
 	}
 }
 
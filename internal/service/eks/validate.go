@@ -26,3 +26,23 @@ func validClusterName(v interface{}, k string) (ws []string, errors []error) {
 
 	return
 }
+
+// https://docs.aws.amazon.com/eks/latest/userguide/access-policies.html
+var accessScopeNamespaceRegexp = regexache.MustCompile(`^([0-9a-z]([0-9a-z-]*[0-9a-z])?|[0-9a-z][0-9a-z-]*\*)$`)
+
+// validAccessScopeNamespace validates a Kubernetes namespace name, optionally ending with a "*" wildcard.
+func validAccessScopeNamespace(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 63 {
+		errors = append(errors, fmt.Errorf(
+			"%q length must be between 1-63 characters: %q", k, value))
+	}
+
+	if !accessScopeNamespaceRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a valid Kubernetes namespace name, optionally ending with a \"*\" wildcard: %q",
+			k, value))
+	}
+
+	return
+}
@@ -67,3 +67,65 @@ func TestValidClusterName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidAccessScopeNamespace(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "kube-system",
+			ErrCount: 0,
+		},
+		{
+			Value:    "a",
+			ErrCount: 0,
+		},
+		{
+			Value:    "dev-*",
+			ErrCount: 0,
+		},
+		{
+			Value:    "d*",
+			ErrCount: 0,
+		},
+		{
+			Value:    "*",
+			ErrCount: 1,
+		},
+		{
+			Value:    "dev*-test",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Invalid",
+			ErrCount: 1,
+		},
+		{
+			Value:    "invalid-",
+			ErrCount: 1,
+		},
+		{
+			Value:    "invalid_namespace",
+			ErrCount: 1,
+		},
+		{
+			Value:    ``,
+			ErrCount: 2,
+		},
+		{
+			Value:    sdkacctest.RandStringFromCharSet(64, sdkacctest.CharSetAlpha),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validAccessScopeNamespace(tc.Value, "namespaces")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the EKS Access Scope Namespace to trigger a validation error: %s, expected %d, got %d errors", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
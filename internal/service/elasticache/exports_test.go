@@ -22,6 +22,7 @@ var (
 	FindGlobalReplicationGroupByID       = findGlobalReplicationGroupByID
 	FindReplicationGroupByID             = findReplicationGroupByID
 	FindServerlessCacheByID              = findServerlessCacheByID
+	FindServerlessCacheSnapshots         = findServerlessCacheSnapshots
 	FindUserByID                         = findUserByID
 	FindUserGroupByID                    = findUserGroupByID
 	FindUserGroupAssociationByTwoPartKey = findUserGroupAssociationByTwoPartKey
@@ -283,6 +283,18 @@ func resourceReplicationGroup() *schema.Resource {
 					return strings.ToLower(val.(string))
 				},
 			},
+			"resharding_status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slot_migration_progress_percentage": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"security_group_names": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -651,6 +663,9 @@ func resourceReplicationGroupRead(ctx context.Context, d *schema.ResourceData, m
 
 	d.Set("num_node_groups", len(rgp.NodeGroups))
 	d.Set("replicas_per_node_group", len(rgp.NodeGroups[0].NodeGroupMembers)-1)
+	if err := d.Set("resharding_status", flattenReshardingStatus(rgp.PendingModifiedValues)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resharding_status: %s", err)
+	}
 
 	d.Set("cluster_enabled", rgp.ClusterEnabled)
 	d.Set("replication_group_id", rgp.ReplicationGroupId)
@@ -1072,17 +1087,24 @@ func modifyReplicationGroupShardConfigurationNumNodeGroups(ctx context.Context,
 		input.NodeGroupsToRemove = aws.StringSlice(nodeGroupsToRemove)
 	}
 
-	_, err := conn.ModifyReplicationGroupShardConfigurationWithContext(ctx, input)
+	// The update timeout applies to each shard added or removed, as slots are migrated one shard at a time.
+	shardCount := newNodeGroupCount - oldNodeGroupCount
+	if shardCount < 0 {
+		shardCount = -shardCount
+	}
+	timeout := d.Timeout(schema.TimeoutUpdate) * time.Duration(max(shardCount, 1))
+
+	// A previous modification may still be in progress.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+		return conn.ModifyReplicationGroupShardConfigurationWithContext(ctx, input)
+	}, elasticache.ErrCodeInvalidReplicationGroupStateFault)
 
 	if err != nil {
 		return fmt.Errorf("modifying ElastiCache Replication Group (%s) shard configuration: %w", d.Id(), err)
 	}
 
-	const (
-		delay = 30 * time.Second
-	)
-	if _, err := waitReplicationGroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate), delay); err != nil {
-		return fmt.Errorf("waiting for ElastiCache Replication Group (%s) update: %w", d.Id(), err)
+	if _, err := waitReplicationGroupReshardingCompleted(ctx, conn, d.Id(), newNodeGroupCount, timeout); err != nil {
+		return fmt.Errorf("waiting for ElastiCache Replication Group (%s) resharding: %w", d.Id(), err)
 	}
 
 	return nil
@@ -1272,6 +1294,54 @@ func waitReplicationGroupAvailable(ctx context.Context, conn *elasticache.Elasti
 	return nil, err
 }
 
+// statusReplicationGroupResharding reports the replication group as modifying until any pending resharding has completed.
+func statusReplicationGroupResharding(ctx context.Context, conn *elasticache.ElastiCache, replicationGroupID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findReplicationGroupByID(ctx, conn, replicationGroupID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.PendingModifiedValues != nil && output.PendingModifiedValues.Resharding != nil {
+			return output, replicationGroupStatusModifying, nil
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitReplicationGroupReshardingCompleted(ctx context.Context, conn *elasticache.ElastiCache, replicationGroupID string, nodeGroupCount int, timeout time.Duration) (*elasticache.ReplicationGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			replicationGroupStatusModifying,
+			replicationGroupStatusSnapshotting,
+		},
+		Target:     []string{replicationGroupStatusAvailable},
+		Refresh:    statusReplicationGroupResharding(ctx, conn, replicationGroupID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*elasticache.ReplicationGroup); ok {
+		// A failed slot migration is rolled back, leaving the replication group available with its original shards.
+		if err == nil && len(output.NodeGroups) != nodeGroupCount {
+			err = fmt.Errorf("resharding did not complete: expected %d shards, got %d", nodeGroupCount, len(output.NodeGroups))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitReplicationGroupDeleted(ctx context.Context, conn *elasticache.ElastiCache, replicationGroupID string, timeout time.Duration) (*elasticache.ReplicationGroup, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
@@ -1295,6 +1365,18 @@ func waitReplicationGroupDeleted(ctx context.Context, conn *elasticache.ElastiCa
 	return nil, err
 }
 
+func flattenReshardingStatus(apiObject *elasticache.ReplicationGroupPendingModifiedValues) []interface{} {
+	if apiObject == nil || apiObject.Resharding == nil || apiObject.Resharding.SlotMigration == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"slot_migration_progress_percentage": aws.Float64Value(apiObject.Resharding.SlotMigration.ProgressPercentage),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func findReplicationGroupMemberClustersByID(ctx context.Context, conn *elasticache.ElastiCache, id string) ([]*elasticache.CacheCluster, error) {
 	rg, err := findReplicationGroupByID(ctx, conn, id)
 
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticache
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	serverlessCacheSnapshotTypeAutomated = "automated"
+	serverlessCacheSnapshotTypeManual    = "manual"
+)
+
+// @SDKDataSource("aws_elasticache_serverless_cache_snapshot", name="Serverless Cache Snapshot")
+func dataSourceServerlessCacheSnapshot() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceServerlessCacheSnapshotRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bytes_used_for_cache": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreateTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrEngine: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiry_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrKMSKeyID: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"major_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrMostRecent: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"serverless_cache_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"snapshot_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					serverlessCacheSnapshotTypeAutomated,
+					serverlessCacheSnapshotTypeManual,
+				}, false),
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceServerlessCacheSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElastiCacheClient(ctx)
+
+	input := &elasticache.DescribeServerlessCacheSnapshotsInput{}
+
+	if v, ok := d.GetOk(names.AttrName); ok {
+		input.ServerlessCacheSnapshotName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("serverless_cache_name"); ok {
+		input.ServerlessCacheName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snapshot_type"); ok {
+		input.SnapshotType = aws.String(v.(string))
+	}
+
+	snapshots, err := findServerlessCacheSnapshots(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ElastiCache Serverless Cache Snapshots: %s", err)
+	}
+
+	if len(snapshots) < 1 {
+		return sdkdiag.AppendErrorf(diags, "Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	if len(snapshots) > 1 && !d.Get(names.AttrMostRecent).(bool) {
+		return sdkdiag.AppendErrorf(diags, "Your query returned more than one result. Please try a more specific search criteria, or set `most_recent` attribute to true.")
+	}
+
+	snapshot := snapshots[0]
+	for _, v := range snapshots[1:] {
+		if aws.ToTime(v.CreateTime).After(aws.ToTime(snapshot.CreateTime)) {
+			snapshot = v
+		}
+	}
+
+	d.SetId(aws.ToString(snapshot.ServerlessCacheSnapshotName))
+	d.Set(names.AttrARN, snapshot.ARN)
+	d.Set("bytes_used_for_cache", snapshot.BytesUsedForCache)
+	if snapshot.CreateTime != nil {
+		d.Set(names.AttrCreateTime, aws.ToTime(snapshot.CreateTime).Format(time.RFC3339))
+	}
+	if snapshot.ExpiryTime != nil {
+		d.Set("expiry_time", aws.ToTime(snapshot.ExpiryTime).Format(time.RFC3339))
+	}
+	d.Set(names.AttrKMSKeyID, snapshot.KmsKeyId)
+	d.Set(names.AttrName, snapshot.ServerlessCacheSnapshotName)
+	if v := snapshot.ServerlessCacheConfiguration; v != nil {
+		d.Set(names.AttrEngine, v.Engine)
+		d.Set("major_engine_version", v.MajorEngineVersion)
+		d.Set("serverless_cache_name", v.ServerlessCacheName)
+	}
+	d.Set("snapshot_type", snapshot.SnapshotType)
+	d.Set(names.AttrStatus, snapshot.Status)
+
+	return diags
+}
+
+func findServerlessCacheSnapshots(ctx context.Context, conn *elasticache.Client, input *elasticache.DescribeServerlessCacheSnapshotsInput) ([]awstypes.ServerlessCacheSnapshot, error) {
+	var output []awstypes.ServerlessCacheSnapshot
+
+	pages := elasticache.NewDescribeServerlessCacheSnapshotsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ServerlessCacheNotFoundFault](err) || errs.IsA[*awstypes.ServerlessCacheSnapshotNotFoundFault](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ServerlessCacheSnapshots...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticache_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfelasticache "github.com/hashicorp/terraform-provider-aws/internal/service/elasticache"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccElastiCacheServerlessCacheSnapshotDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_serverless_cache.test"
+	dataSourceName := "data.aws_elasticache_serverless_cache_snapshot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElastiCacheServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckServerlessCacheDestroy(ctx),
+			testAccCheckServerlessCacheSnapshotDelete(ctx, rName),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerlessCacheConfig_basicRedis(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessCacheSnapshotCreate(ctx, resourceName, rName),
+				),
+			},
+			{
+				Config: testAccServerlessCacheSnapshotDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrCreateTime),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrEngine, resourceName, names.AttrEngine),
+					resource.TestCheckResourceAttrPair(dataSourceName, "major_engine_version", resourceName, "major_engine_version"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "serverless_cache_name", resourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "snapshot_type", "manual"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, "available"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckServerlessCacheSnapshotCreate takes a manual snapshot of the serverless cache.
+func testAccCheckServerlessCacheSnapshotCreate(ctx context.Context, n, snapshotName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheClient(ctx)
+
+		_, err := conn.CreateServerlessCacheSnapshot(ctx, &elasticache.CreateServerlessCacheSnapshotInput{
+			ServerlessCacheName:         aws.String(rs.Primary.ID),
+			ServerlessCacheSnapshotName: aws.String(snapshotName),
+		})
+
+		if err != nil {
+			return fmt.Errorf("creating ElastiCache Serverless Cache Snapshot (%s): %w", snapshotName, err)
+		}
+
+		input := &elasticache.DescribeServerlessCacheSnapshotsInput{
+			ServerlessCacheSnapshotName: aws.String(snapshotName),
+		}
+
+		return tfresource.WaitUntil(ctx, 30*time.Minute, func() (bool, error) {
+			output, err := tfelasticache.FindServerlessCacheSnapshots(ctx, conn, input)
+
+			if err != nil {
+				return false, err
+			}
+
+			return len(output) == 1 && aws.ToString(output[0].Status) == "available", nil
+		}, tfresource.WaitOpts{
+			Delay:        30 * time.Second,
+			PollInterval: 30 * time.Second,
+		})
+	}
+}
+
+// testAccCheckServerlessCacheSnapshotDelete deletes the manual snapshot, which outlives the serverless cache.
+func testAccCheckServerlessCacheSnapshotDelete(ctx context.Context, snapshotName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheClient(ctx)
+
+		_, err := conn.DeleteServerlessCacheSnapshot(ctx, &elasticache.DeleteServerlessCacheSnapshotInput{
+			ServerlessCacheSnapshotName: aws.String(snapshotName),
+		})
+
+		if errs.IsA[*awstypes.ServerlessCacheSnapshotNotFoundFault](err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting ElastiCache Serverless Cache Snapshot (%s): %w", snapshotName, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccServerlessCacheSnapshotDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccServerlessCacheConfig_basicRedis(rName), `
+data "aws_elasticache_serverless_cache_snapshot" "test" {
+  serverless_cache_name = aws_elasticache_serverless_cache.test.name
+  snapshot_type         = "manual"
+  most_recent           = true
+}
+`)
+}
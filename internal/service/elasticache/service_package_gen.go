@@ -46,6 +46,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_elasticache_replication_group",
 			Name:     "Replication Group",
 		},
+		{
+			Factory:  dataSourceServerlessCacheSnapshot,
+			TypeName: "aws_elasticache_serverless_cache_snapshot",
+			Name:     "Serverless Cache Snapshot",
+		},
 		{
 			Factory:  dataSourceSubnetGroup,
 			TypeName: "aws_elasticache_subnet_group",
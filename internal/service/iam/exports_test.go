@@ -43,6 +43,8 @@ var (
 	FindGroupByName                     = findGroupByName
 	FindInstanceProfileByName           = findInstanceProfileByName
 	FindOpenIDConnectProviderByARN      = findOpenIDConnectProviderByARN
+	FindRoleAttachedPolicies            = findRoleAttachedPolicies
+	FindRolePolicyNames                 = findRolePolicyNames
 	FindPolicyByARN                     = findPolicyByARN
 	FindSAMLProviderByARN               = findSAMLProviderByARN
 	FindServerCertificateByName         = findServerCertificateByName
@@ -5,12 +5,24 @@ package iam
 
 import (
 	"context"
+	"crypto/sha1"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -53,13 +65,20 @@ func resourceOpenIDConnectProvider() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"thumbprint_auto_refresh": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"thumbprint_list", "use_trust_store"},
+			},
 			"thumbprint_list": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validation.StringLenBetween(40, 40),
 				},
+				ConflictsWith: []string{"use_trust_store"},
 			},
 			names.AttrURL: {
 				Type:             schema.TypeString,
@@ -68,9 +87,17 @@ func resourceOpenIDConnectProvider() *schema.Resource {
 				ValidateFunc:     validOpenIDURL,
 				DiffSuppressFunc: suppressOpenIDURL,
 			},
+			"use_trust_store": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceOpenIDConnectProviderCustomizeDiff,
+		),
 	}
 }
 
@@ -79,10 +106,25 @@ func resourceOpenIDConnectProviderCreate(ctx context.Context, d *schema.Resource
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
 	input := &iam.CreateOpenIDConnectProviderInput{
-		ClientIDList:   flex.ExpandStringValueSet(d.Get("client_id_list").(*schema.Set)),
-		Tags:           getTagsIn(ctx),
-		ThumbprintList: flex.ExpandStringValueList(d.Get("thumbprint_list").([]interface{})),
-		Url:            aws.String(d.Get(names.AttrURL).(string)),
+		ClientIDList: flex.ExpandStringValueSet(d.Get("client_id_list").(*schema.Set)),
+		Tags:         getTagsIn(ctx),
+		Url:          aws.String(d.Get(names.AttrURL).(string)),
+	}
+
+	// With use_trust_store, IAM relies on its library of trusted root certificate authorities
+	// to verify the identity provider and no thumbprints are sent.
+	if !d.Get("use_trust_store").(bool) {
+		input.ThumbprintList = flex.ExpandStringValueList(d.Get("thumbprint_list").([]interface{}))
+	}
+
+	if d.Get("thumbprint_auto_refresh").(bool) {
+		thumbprint, err := openIDConnectProviderThumbprint(ctx, d.Get(names.AttrURL).(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating IAM OIDC Provider: computing thumbprint: %s", err)
+		}
+
+		input.ThumbprintList = []string{thumbprint}
 	}
 
 	output, err := conn.CreateOpenIDConnectProvider(ctx, input)
@@ -148,7 +190,23 @@ func resourceOpenIDConnectProviderUpdate(ctx context.Context, d *schema.Resource
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
-	if d.HasChange("thumbprint_list") {
+	if d.Get("thumbprint_auto_refresh").(bool) {
+		// If the identity provider can't be reached, keep the current thumbprint.
+		if thumbprint, err := openIDConnectProviderThumbprint(ctx, d.Get(names.AttrURL).(string)); err != nil {
+			diags = sdkdiag.AppendWarningf(diags, "refreshing IAM OIDC Provider (%s) thumbprint: %s", d.Id(), err)
+		} else if o, _ := d.GetChange("thumbprint_list"); !slices.Equal(flex.ExpandStringValueList(o.([]interface{})), []string{thumbprint}) {
+			input := &iam.UpdateOpenIDConnectProviderThumbprintInput{
+				OpenIDConnectProviderArn: aws.String(d.Id()),
+				ThumbprintList:           []string{thumbprint},
+			}
+
+			_, err := conn.UpdateOpenIDConnectProviderThumbprint(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IAM OIDC Provider (%s) thumbprint: %s", d.Id(), err)
+			}
+		}
+	} else if d.HasChange("thumbprint_list") && !d.Get("use_trust_store").(bool) {
 		input := &iam.UpdateOpenIDConnectProviderThumbprintInput{
 			OpenIDConnectProviderArn: aws.String(d.Id()),
 			ThumbprintList:           flex.ExpandStringValueList(d.Get("thumbprint_list").([]interface{})),
@@ -217,6 +275,106 @@ func resourceOpenIDConnectProviderDelete(ctx context.Context, d *schema.Resource
 	return diags
 }
 
+func resourceOpenIDConnectProviderCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("thumbprint_auto_refresh").(bool) {
+		return nil
+	}
+
+	// The thumbprint is computed when the provider is created.
+	// The URL may also not be known until apply, for example when it is an EKS cluster's OIDC issuer.
+	if diff.Id() == "" || !diff.NewValueKnown(names.AttrURL) {
+		return diff.SetNewComputed("thumbprint_list")
+	}
+
+	// Check whether the identity provider's certificate chain has changed, so that a rotated certificate
+	// is picked up on the next apply. The new thumbprint is computed again on apply.
+	thumbprint, err := openIDConnectProviderThumbprint(ctx, diff.Get(names.AttrURL).(string))
+
+	if err != nil {
+		log.Printf("[WARN] computing IAM OIDC Provider (%s) thumbprint, keeping current thumbprint_list: %s", diff.Id(), err)
+		return nil
+	}
+
+	if o := flex.ExpandStringValueList(diff.Get("thumbprint_list").([]interface{})); !slices.Equal(o, []string{thumbprint}) {
+		return diff.SetNewComputed("thumbprint_list")
+	}
+
+	return nil
+}
+
+// openIDConnectProviderThumbprint returns the SHA-1 thumbprint of the top intermediate certificate
+// authority served by the host of the identity provider's JSON Web Key Set endpoint.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_providers_create_oidc_verify-thumbprint.html.
+func openIDConnectProviderThumbprint(ctx context.Context, providerURL string) (string, error) {
+	if !strings.HasPrefix(providerURL, "https://") {
+		providerURL = "https://" + providerURL
+	}
+
+	configurationURL := strings.TrimSuffix(providerURL, "/") + "/.well-known/openid-configuration"
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, configurationURL, nil)
+
+	if err != nil {
+		return "", err
+	}
+
+	response, err := cleanhttp.DefaultClient().Do(request)
+
+	if err != nil {
+		return "", fmt.Errorf("reading OpenID configuration (%s): %w", configurationURL, err)
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("reading OpenID configuration (%s): unexpected HTTP status %s", configurationURL, response.Status)
+	}
+
+	var configuration struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&configuration); err != nil {
+		return "", fmt.Errorf("decoding OpenID configuration (%s): %w", configurationURL, err)
+	}
+
+	jwksURL, err := url.Parse(configuration.JWKSURI)
+
+	if err != nil {
+		return "", fmt.Errorf("parsing jwks_uri (%s): %w", configuration.JWKSURI, err)
+	}
+
+	host := jwksURL.Host
+	if jwksURL.Port() == "" {
+		host = net.JoinHostPort(jwksURL.Hostname(), "443")
+	}
+
+	dialer := &tls.Dialer{
+		Config: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			ServerName: jwksURL.Hostname(),
+		},
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", host)
+
+	if err != nil {
+		return "", fmt.Errorf("connecting to %s: %w", host, err)
+	}
+
+	defer conn.Close()
+
+	certificates := conn.(*tls.Conn).ConnectionState().PeerCertificates
+
+	if len(certificates) == 0 {
+		return "", fmt.Errorf("no certificates served by %s", host)
+	}
+
+	// The last certificate in the chain is the top intermediate (or root) certificate authority.
+	// IAM OIDC provider thumbprints are always SHA-1.
+	thumbprint := sha1.Sum(certificates[len(certificates)-1].Raw)
+
+	return hex.EncodeToString(thumbprint[:]), nil
+}
+
 func findOpenIDConnectProviderByARN(ctx context.Context, conn *iam.Client, arn string) (*iam.GetOpenIDConnectProviderOutput, error) {
 	input := &iam.GetOpenIDConnectProviderInput{
 		OpenIDConnectProviderArn: aws.String(arn),
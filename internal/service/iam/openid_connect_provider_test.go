@@ -10,6 +10,7 @@ import (
 
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -89,6 +90,74 @@ func TestAccIAMOpenIDConnectProvider_disappears(t *testing.T) {
 	})
 }
 
+func TestAccIAMOpenIDConnectProvider_useTrustStore(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_iam_openid_connect_provider.test"
+
+	// The provider URL is fixed, so these tests cannot run in parallel.
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpenIDConnectProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenIDConnectProviderConfig_useTrustStore(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpenIDConnectProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "use_trust_store", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"use_trust_store"},
+			},
+			{
+				// IAM keeps the thumbprints of an existing provider, so the provider is replaced.
+				Config: testAccOpenIDConnectProviderConfig_thumbprintAutoRefresh(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpenIDConnectProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "thumbprint_list.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMOpenIDConnectProvider_thumbprintAutoRefresh(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_iam_openid_connect_provider.test"
+
+	// The provider URL is fixed, so these tests cannot run in parallel.
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpenIDConnectProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenIDConnectProviderConfig_thumbprintAutoRefresh(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpenIDConnectProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "thumbprint_auto_refresh", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "thumbprint_list.#", acctest.Ct1),
+				),
+			},
+			{
+				Config:   testAccOpenIDConnectProviderConfig_thumbprintAutoRefresh(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccIAMOpenIDConnectProvider_clientIDListOrder(t *testing.T) {
 	ctx := acctest.Context(t)
 	rString := sdkacctest.RandString(5)
@@ -240,6 +309,30 @@ resource "aws_iam_openid_connect_provider" "test" {
 `, rName)
 }
 
+func testAccOpenIDConnectProviderConfig_useTrustStore() string {
+	return `
+resource "aws_iam_openid_connect_provider" "test" {
+  url = "https://token.actions.githubusercontent.com"
+
+  client_id_list = ["sts.amazonaws.com"]
+
+  use_trust_store = true
+}
+`
+}
+
+func testAccOpenIDConnectProviderConfig_thumbprintAutoRefresh() string {
+	return `
+resource "aws_iam_openid_connect_provider" "test" {
+  url = "https://token.actions.githubusercontent.com"
+
+  client_id_list = ["sts.amazonaws.com"]
+
+  thumbprint_auto_refresh = true
+}
+`
+}
+
 func testAccOpenIDConnectProviderConfig_clientIDList_first(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_openid_connect_provider" "test" {
@@ -5,15 +5,19 @@ package iam
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/url"
 	"reflect"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	accessanalyzertypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -101,9 +105,16 @@ func resourcePolicy() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"validate_policy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourcePolicyCustomizeDiff,
+		),
 	}
 }
 
@@ -141,6 +152,10 @@ func resourcePolicyCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.SetId(aws.ToString(output.Policy.Arn))
 
+	if d.Get("validate_policy").(bool) {
+		diags = appendPolicyValidationWarnings(ctx, meta.(*conns.AWSClient).AccessAnalyzerClient(ctx), diags, policy)
+	}
+
 	// For partitions not supporting tag-on-create, attempt tag after create.
 	if tags := getTagsIn(ctx); input.Tags == nil && len(tags) > 0 {
 		err := policyCreateTags(ctx, conn, d.Id(), tags)
@@ -248,6 +263,10 @@ func resourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating IAM Policy (%s): %s", d.Id(), err)
 		}
+
+		if d.Get("validate_policy").(bool) {
+			diags = appendPolicyValidationWarnings(ctx, meta.(*conns.AWSClient).AccessAnalyzerClient(ctx), diags, policy)
+		}
 	}
 
 	return append(diags, resourcePolicyRead(ctx, d, meta)...)
@@ -294,6 +313,98 @@ func resourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	return diags
 }
 
+func resourcePolicyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("validate_policy").(bool) {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChanges(names.AttrPolicy, "validate_policy") {
+		return nil
+	}
+
+	// The policy may not be known until apply, e.g. when it references other resources.
+	if !d.NewValueKnown(names.AttrPolicy) {
+		return nil
+	}
+
+	policy, err := structure.NormalizeJsonString(d.Get(names.AttrPolicy).(string))
+	if err != nil {
+		return fmt.Errorf("policy (%s) is invalid JSON: %w", policy, err)
+	}
+
+	findings, err := findPolicyValidationFindings(ctx, meta.(*conns.AWSClient).AccessAnalyzerClient(ctx), policy)
+
+	if err != nil {
+		return fmt.Errorf("validating IAM Policy document: %w", err)
+	}
+
+	var errsList []error
+
+	for _, v := range findings {
+		if v.FindingType == accessanalyzertypes.ValidatePolicyFindingTypeError {
+			errsList = append(errsList, errors.New(policyValidationFindingString(v)))
+		} else {
+			// Only apply-time diagnostics can carry warnings, see appendPolicyValidationWarnings.
+			log.Printf("[WARN] IAM Policy document validation: %s", policyValidationFindingString(v))
+		}
+	}
+
+	if err := errors.Join(errsList...); err != nil {
+		return fmt.Errorf("IAM Policy document failed validation: %w", err)
+	}
+
+	return nil
+}
+
+// appendPolicyValidationWarnings adds a warning for each non-error IAM Access Analyzer finding for the policy document.
+// Error findings have already been reported at plan time.
+func appendPolicyValidationWarnings(ctx context.Context, conn *accessanalyzer.Client, diags diag.Diagnostics, policy string) diag.Diagnostics {
+	findings, err := findPolicyValidationFindings(ctx, conn, policy)
+
+	if err != nil {
+		return sdkdiag.AppendWarningf(diags, "validating IAM Policy document: %s", err)
+	}
+
+	for _, v := range findings {
+		if v.FindingType != accessanalyzertypes.ValidatePolicyFindingTypeError {
+			diags = sdkdiag.AppendWarningf(diags, "IAM Policy document validation: %s", policyValidationFindingString(v))
+		}
+	}
+
+	return diags
+}
+
+func policyValidationFindingString(apiObject accessanalyzertypes.ValidatePolicyFinding) string {
+	s := fmt.Sprintf("%s %s: %s", apiObject.FindingType, aws.ToString(apiObject.IssueCode), aws.ToString(apiObject.FindingDetails))
+
+	if v := aws.ToString(apiObject.LearnMoreLink); v != "" {
+		s = fmt.Sprintf("%s (%s)", s, v)
+	}
+
+	return s
+}
+
+func findPolicyValidationFindings(ctx context.Context, conn *accessanalyzer.Client, policy string) ([]accessanalyzertypes.ValidatePolicyFinding, error) {
+	input := &accessanalyzer.ValidatePolicyInput{
+		PolicyDocument: aws.String(policy),
+		PolicyType:     accessanalyzertypes.PolicyTypeIdentityPolicy,
+	}
+	var output []accessanalyzertypes.ValidatePolicyFinding
+
+	pages := accessanalyzer.NewValidatePolicyPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Findings...)
+	}
+
+	return output, nil
+}
+
 // policyPruneVersions deletes the oldest version.
 //
 // Old versions are deleted until there are 4 or less remaining, which means at
@@ -349,6 +349,39 @@ func TestAccIAMPolicy_policyDuplicateKeys(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicy_validatePolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var out awstypes.Policy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_validatePolicy(rName, "2019-01-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &out),
+					resource.TestCheckResourceAttr(resourceName, "validate_policy", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validate_policy"},
+			},
+			{
+				Config:      testAccPolicyConfig_validatePolicy(rName, "not-a-date"),
+				ExpectError: regexache.MustCompile(`IAM Policy document failed validation`),
+			},
+		},
+	})
+}
+
 func testAccCheckPolicyExists(ctx context.Context, n string, v *awstypes.Policy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -624,3 +657,26 @@ EOF
 }
 `, rName)
 }
+
+func testAccPolicyConfig_validatePolicy(rName, currentTime string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "test" {
+  name            = %[1]q
+  validate_policy = true
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "ec2:Describe*"
+      Effect   = "Allow"
+      Resource = "*"
+      Condition = {
+        DateGreaterThan = {
+          "aws:CurrentTime" = %[2]q
+        }
+      }
+    }]
+  })
+}
+`, rName, currentTime)
+}
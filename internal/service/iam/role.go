@@ -317,7 +317,7 @@ func resourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	}
 
 	if !inlinePoliciesEquivalent(inlinePolicies, configPoliciesList) {
-		if err := d.Set("inline_policy", flattenRoleInlinePolicies(inlinePolicies)); err != nil {
+		if err := d.Set("inline_policy", flattenRoleInlinePolicies(inlinePoliciesToSet(inlinePolicies, configPoliciesList))); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting inline_policy: %s", err)
 		}
 	}
@@ -941,7 +941,7 @@ func inlinePoliciesEquivalent(readPolicies, configPolicies []*iam.PutRolePolicyI
 		for _, policyTwo := range configPolicies {
 			if aws.ToString(policyOne.PolicyName) == aws.ToString(policyTwo.PolicyName) {
 				matches++
-				if equivalent, err := awspolicy.PoliciesAreEquivalent(aws.ToString(policyOne.PolicyDocument), aws.ToString(policyTwo.PolicyDocument)); err != nil || !equivalent {
+				if !verify.PolicyStringsEquivalent(aws.ToString(policyOne.PolicyDocument), aws.ToString(policyTwo.PolicyDocument)) {
 					return false
 				}
 				break
@@ -952,6 +952,35 @@ func inlinePoliciesEquivalent(readPolicies, configPolicies []*iam.PutRolePolicyI
 	return matches == len(readPolicies)
 }
 
+// inlinePoliciesToSet returns the read policies, keeping the configured policy document
+// for any policy that is semantically equivalent to its configured counterpart.
+// This prevents differently ordered but equivalent policies from showing a difference
+// when another inline policy has actually changed.
+func inlinePoliciesToSet(readPolicies, configPolicies []*iam.PutRolePolicyInput) []*iam.PutRolePolicyInput {
+	configDocuments := make(map[string]string, len(configPolicies))
+	for _, v := range configPolicies {
+		configDocuments[aws.ToString(v.PolicyName)] = aws.ToString(v.PolicyDocument)
+	}
+
+	apiObjects := make([]*iam.PutRolePolicyInput, 0, len(readPolicies))
+
+	for _, v := range readPolicies {
+		apiObject := v
+
+		if document, ok := configDocuments[aws.ToString(v.PolicyName)]; ok && verify.PolicyStringsEquivalent(aws.ToString(v.PolicyDocument), document) {
+			apiObject = &iam.PutRolePolicyInput{
+				PolicyDocument: aws.String(document),
+				PolicyName:     v.PolicyName,
+				RoleName:       v.RoleName,
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
 func roleTags(ctx context.Context, conn *iam.Client, identifier string) ([]awstypes.Tag, error) {
 	output, err := conn.ListRoleTags(ctx, &iam.ListRoleTagsInput{
 		RoleName: aws.String(identifier),
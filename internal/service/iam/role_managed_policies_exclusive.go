@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_iam_role_managed_policies_exclusive", name="Role Managed Policies Exclusive")
+func resourceRoleManagedPoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRoleManagedPoliciesExclusivePut,
+		ReadWithoutTimeout:   resourceRoleManagedPoliciesExclusiveRead,
+		UpdateWithoutTimeout: resourceRoleManagedPoliciesExclusivePut,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRoleManagedPoliciesExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	roleName := d.Get("role_name").(string)
+
+	policyARNs, err := findRoleAttachedPolicies(ctx, conn, roleName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role (%s) attached policies: %s", roleName, err)
+	}
+
+	add, remove, _ := flex.DiffSlices(policyARNs, flex.ExpandStringValueSet(d.Get("policy_arns").(*schema.Set)), func(s1, s2 string) bool {
+		return s1 == s2
+	})
+
+	for _, policyARN := range add {
+		if err := attachPolicyToRole(ctx, conn, roleName, policyARN); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	for _, policyARN := range remove {
+		if err := detachPolicyFromRole(ctx, conn, roleName, policyARN); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if d.IsNewResource() {
+		d.SetId(roleName)
+	}
+
+	return append(diags, resourceRoleManagedPoliciesExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceRoleManagedPoliciesExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	policyARNs, err := findRoleAttachedPolicies(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role Managed Policies Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role Managed Policies Exclusive (%s): %s", d.Id(), err)
+	}
+
+	d.Set("policy_arns", policyARNs)
+	d.Set("role_name", d.Id())
+
+	return diags
+}
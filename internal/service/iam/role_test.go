@@ -677,6 +677,37 @@ func TestAccIAMRole_InlinePolicy_ignoreOrder(t *testing.T) {
 	})
 }
 
+func TestAccIAMRole_InlinePolicy_ignoreOrderWithOtherChange(t *testing.T) {
+	ctx := acctest.Context(t)
+	var role awstypes.Role
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleConfig_policyInlineOrderWithOtherPolicy(rName, `"ec2:DescribeElasticGpus", "ec2:DescribeScheduledInstances"`, "s3:ListBucket"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(ctx, resourceName, &role),
+					resource.TestCheckResourceAttr(resourceName, "inline_policy.#", acctest.Ct2),
+				),
+			},
+			{
+				// Reordering one policy while changing the other must not leave a difference after apply.
+				Config: testAccRoleConfig_policyInlineOrderWithOtherPolicy(rName, `"ec2:DescribeScheduledInstances", "ec2:DescribeElasticGpus"`, "s3:GetObject"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(ctx, resourceName, &role),
+					resource.TestCheckResourceAttr(resourceName, "inline_policy.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIAMRole_InlinePolicy_empty(t *testing.T) {
 	ctx := acctest.Context(t)
 	var role awstypes.Role
@@ -1912,6 +1943,54 @@ resource "aws_iam_role" "test" {
 `, roleName)
 }
 
+func testAccRoleConfig_policyInlineOrderWithOtherPolicy(roleName, actions, otherAction string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole",
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}",
+      }
+      Effect = "Allow"
+      Sid    = ""
+    }]
+  })
+
+  inline_policy {
+    name = "%[1]s-1"
+
+    policy = jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Action   = [%[2]s]
+        Effect   = "Allow"
+        Resource = "*"
+      }]
+    })
+  }
+
+  inline_policy {
+    name = "%[1]s-2"
+
+    policy = jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Action   = %[3]q
+        Effect   = "Allow"
+        Resource = "*"
+      }]
+    })
+  }
+}
+`, roleName, actions, otherAction)
+}
+
 func testAccRoleConfig_policyInlineActionNewOrder(roleName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
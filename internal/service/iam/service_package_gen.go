@@ -194,6 +194,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				ResourceType:        "Role",
 			},
 		},
+		{
+			Factory:  resourceRoleManagedPoliciesExclusive,
+			TypeName: "aws_iam_role_managed_policies_exclusive",
+			Name:     "Role Managed Policies Exclusive",
+		},
+		{
+			Factory:  resourceRolePoliciesExclusive,
+			TypeName: "aws_iam_role_policies_exclusive",
+			Name:     "Role Policies Exclusive",
+		},
 		{
 			Factory:  resourceRolePolicy,
 			TypeName: "aws_iam_role_policy",
@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameGroupMemberships = "GroupMemberships"
+
+	// The Identity Store API has no batch operations for group memberships,
+	// so up to this many single-membership calls are made concurrently.
+	groupMembershipsBatchSize = 10
+)
+
+// @SDKResource("aws_identitystore_group_memberships")
+func ResourceGroupMemberships() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupMembershipsPut,
+		ReadWithoutTimeout:   resourceGroupMembershipsRead,
+		UpdateWithoutTimeout: resourceGroupMembershipsPut,
+		DeleteWithoutTimeout: resourceGroupMembershipsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 47),
+			},
+
+			"identity_store_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 36),
+			},
+
+			"member_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 47),
+				},
+			},
+		},
+	}
+}
+
+func resourceGroupMembershipsPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IdentityStoreClient(ctx)
+
+	identityStoreID := d.Get("identity_store_id").(string)
+	groupID := d.Get("group_id").(string)
+	id := groupMembershipsCreateResourceID(identityStoreID, groupID)
+
+	action := create.ErrActionUpdating
+	if d.IsNewResource() {
+		action = create.ErrActionCreating
+	}
+
+	// Membership IDs are needed to delete members, and only the group's memberships listing has them.
+	memberships, err := findGroupMembershipsByTwoPartKey(ctx, conn, identityStoreID, groupID)
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, action, ResNameGroupMemberships, id, err)
+	}
+
+	memberIDs := make(map[string]struct{})
+	for _, memberID := range flex.ExpandStringValueSet(d.Get("member_ids").(*schema.Set)) {
+		memberIDs[memberID] = struct{}{}
+	}
+
+	var add, remove []string
+
+	for memberID := range memberIDs {
+		if _, ok := memberships[memberID]; !ok {
+			add = append(add, memberID)
+		}
+	}
+
+	for memberID, membershipID := range memberships {
+		if _, ok := memberIDs[memberID]; !ok {
+			remove = append(remove, membershipID)
+		}
+	}
+
+	if err := groupMembershipsDo(ctx, remove, func(ctx context.Context, membershipID string) error {
+		return deleteGroupMembership(ctx, conn, identityStoreID, membershipID)
+	}); err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, action, ResNameGroupMemberships, id, err)
+	}
+
+	if err := groupMembershipsDo(ctx, add, func(ctx context.Context, memberID string) error {
+		return createGroupMembership(ctx, conn, identityStoreID, groupID, memberID)
+	}); err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, action, ResNameGroupMemberships, id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return append(diags, resourceGroupMembershipsRead(ctx, d, meta)...)
+}
+
+func resourceGroupMembershipsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IdentityStoreClient(ctx)
+
+	identityStoreID, groupID, err := groupMembershipsParseResourceID(d.Id())
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionReading, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	memberships, err := findGroupMembershipsByTwoPartKey(ctx, conn, identityStoreID, groupID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IdentityStore GroupMemberships (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionReading, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	memberIDs := make([]string, 0, len(memberships))
+	for memberID := range memberships {
+		memberIDs = append(memberIDs, memberID)
+	}
+
+	d.Set("group_id", groupID)
+	d.Set("identity_store_id", identityStoreID)
+	d.Set("member_ids", memberIDs)
+
+	return diags
+}
+
+func resourceGroupMembershipsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IdentityStoreClient(ctx)
+
+	identityStoreID := d.Get("identity_store_id").(string)
+	groupID := d.Get("group_id").(string)
+
+	memberships, err := findGroupMembershipsByTwoPartKey(ctx, conn, identityStoreID, groupID)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionDeleting, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	// Only remove the members managed by this resource.
+	var remove []string
+
+	for _, memberID := range flex.ExpandStringValueSet(d.Get("member_ids").(*schema.Set)) {
+		if membershipID, ok := memberships[memberID]; ok {
+			remove = append(remove, membershipID)
+		}
+	}
+
+	log.Printf("[INFO] Deleting IdentityStore GroupMemberships %s", d.Id())
+
+	if err := groupMembershipsDo(ctx, remove, func(ctx context.Context, membershipID string) error {
+		return deleteGroupMembership(ctx, conn, identityStoreID, membershipID)
+	}); err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionDeleting, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	return diags
+}
+
+const groupMembershipsResourceIDSeparator = "/"
+
+func groupMembershipsCreateResourceID(identityStoreID, groupID string) string {
+	parts := []string{identityStoreID, groupID}
+	id := strings.Join(parts, groupMembershipsResourceIDSeparator)
+
+	return id
+}
+
+func groupMembershipsParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, groupMembershipsResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected IDENTITYSTOREID%[2]sGROUPID", id, groupMembershipsResourceIDSeparator)
+}
+
+// groupMembershipsDo calls f for each value, in batches of concurrent calls.
+func groupMembershipsDo(ctx context.Context, values []string, f func(context.Context, string) error) error {
+	var errsList []error
+
+	for _, chunk := range tfslices.Chunks(values, groupMembershipsBatchSize) {
+		var wg sync.WaitGroup
+		chunkErrs := make([]error, len(chunk))
+
+		for i, v := range chunk {
+			wg.Add(1)
+			go func(i int, v string) {
+				defer wg.Done()
+				chunkErrs[i] = f(ctx, v)
+			}(i, v)
+		}
+
+		wg.Wait()
+
+		errsList = append(errsList, chunkErrs...)
+	}
+
+	return errors.Join(errsList...)
+}
+
+func createGroupMembership(ctx context.Context, conn *identitystore.Client, identityStoreID, groupID, memberID string) error {
+	input := &identitystore.CreateGroupMembershipInput{
+		GroupId:         aws.String(groupID),
+		IdentityStoreId: aws.String(identityStoreID),
+		MemberId:        &types.MemberIdMemberUserId{Value: memberID},
+	}
+
+	_, err := conn.CreateGroupMembership(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("adding member (%s): %w", memberID, err)
+	}
+
+	return nil
+}
+
+func deleteGroupMembership(ctx context.Context, conn *identitystore.Client, identityStoreID, membershipID string) error {
+	input := &identitystore.DeleteGroupMembershipInput{
+		IdentityStoreId: aws.String(identityStoreID),
+		MembershipId:    aws.String(membershipID),
+	}
+
+	_, err := conn.DeleteGroupMembership(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("removing membership (%s): %w", membershipID, err)
+	}
+
+	return nil
+}
+
+// findGroupMembershipsByTwoPartKey returns the group's user memberships as a map of member ID to membership ID.
+func findGroupMembershipsByTwoPartKey(ctx context.Context, conn *identitystore.Client, identityStoreID, groupID string) (map[string]string, error) {
+	input := &identitystore.ListGroupMembershipsInput{
+		GroupId:         aws.String(groupID),
+		IdentityStoreId: aws.String(identityStoreID),
+	}
+	output := make(map[string]string)
+
+	pages := identitystore.NewListGroupMembershipsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.GroupMemberships {
+			if memberID, ok := v.MemberId.(*types.MemberIdMemberUserId); ok {
+				output[memberID.Value] = aws.ToString(v.MembershipId)
+			}
+		}
+	}
+
+	return output, nil
+}
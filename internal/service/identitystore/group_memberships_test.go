@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfidentitystore "github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIdentityStoreGroupMemberships_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_identitystore_group_memberships.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupMembershipsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipsConfig_basic(rName, 3, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, "group_id", "aws_identitystore_group.test", "group_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity_store_id"),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "member_ids.*", "aws_identitystore_user.test.0", "user_id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "member_ids.*", "aws_identitystore_user.test.1", "user_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGroupMembershipsConfig_basic(rName, 3, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsCount(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", acctest.Ct3),
+				),
+			},
+			{
+				Config: testAccGroupMembershipsConfig_basic(rName, 3, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "member_ids.*", "aws_identitystore_user.test.0", "user_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityStoreGroupMemberships_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_identitystore_group_memberships.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupMembershipsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipsConfig_basic(rName, 2, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsCount(ctx, resourceName, 2),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfidentitystore.ResourceGroupMemberships(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIdentityStoreGroupMemberships_outOfBandMember(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_identitystore_group_memberships.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupMembershipsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipsConfig_basic(rName, 2, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsCount(ctx, resourceName, 1),
+					testAccCheckGroupMembershipsAddOutOfBand(ctx, resourceName, "aws_identitystore_user.test.1"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccGroupMembershipsConfig_basic(rName, 2, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGroupMembershipsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IdentityStoreClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_identitystore_group_memberships" {
+				continue
+			}
+
+			output, err := listGroupMemberships(ctx, conn, rs.Primary.Attributes["identity_store_id"], rs.Primary.Attributes["group_id"])
+
+			if err != nil {
+				var nfe *types.ResourceNotFoundException
+				if errors.As(err, &nfe) {
+					continue
+				}
+				return err
+			}
+
+			if len(output) == 0 {
+				continue
+			}
+
+			return create.Error(names.IdentityStore, create.ErrActionCheckingDestroyed, tfidentitystore.ResNameGroupMemberships, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGroupMembershipsCount(ctx context.Context, name string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.IdentityStore, create.ErrActionCheckingExistence, tfidentitystore.ResNameGroupMemberships, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IdentityStoreClient(ctx)
+
+		output, err := listGroupMemberships(ctx, conn, rs.Primary.Attributes["identity_store_id"], rs.Primary.Attributes["group_id"])
+
+		if err != nil {
+			return create.Error(names.IdentityStore, create.ErrActionCheckingExistence, tfidentitystore.ResNameGroupMemberships, rs.Primary.ID, err)
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("IdentityStore Group (%s) membership count = %d, want %d", rs.Primary.Attributes["group_id"], got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGroupMembershipsAddOutOfBand(ctx context.Context, name, userResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		user, ok := s.RootModule().Resources[userResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", userResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IdentityStoreClient(ctx)
+
+		_, err := conn.CreateGroupMembership(ctx, &identitystore.CreateGroupMembershipInput{
+			GroupId:         aws.String(rs.Primary.Attributes["group_id"]),
+			IdentityStoreId: aws.String(rs.Primary.Attributes["identity_store_id"]),
+			MemberId:        &types.MemberIdMemberUserId{Value: user.Primary.Attributes["user_id"]},
+		})
+
+		return err
+	}
+}
+
+func listGroupMemberships(ctx context.Context, conn *identitystore.Client, identityStoreID, groupID string) ([]types.GroupMembership, error) {
+	var output []types.GroupMembership
+
+	pages := identitystore.NewListGroupMembershipsPaginator(conn, &identitystore.ListGroupMembershipsInput{
+		GroupId:         aws.String(groupID),
+		IdentityStoreId: aws.String(identityStoreID),
+	})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.GroupMemberships...)
+	}
+
+	return output, nil
+}
+
+func testAccGroupMembershipsConfig_basic(rName string, userCount, memberCount int) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_user" "test" {
+  count = %[2]d
+
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  display_name = "Acceptance Test"
+  user_name    = "%[1]s-${count.index}"
+
+  name {
+    family_name = "Doe"
+    given_name  = "John"
+  }
+}
+
+resource "aws_identitystore_group" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  display_name      = %[1]q
+  description       = "Acceptance Test"
+}
+
+resource "aws_identitystore_group_memberships" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  group_id   = aws_identitystore_group.test.group_id
+  member_ids = slice(aws_identitystore_user.test[*].user_id, 0, %[3]d)
+}
+`, rName, userCount, memberCount)
+}
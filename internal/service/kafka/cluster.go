@@ -5,8 +5,10 @@ package kafka
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/kafka"
 	"github.com/aws/aws-sdk-go-v2/service/kafka/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -54,6 +57,11 @@ func resourceCluster() *schema.Resource {
 			customdiff.ForceNewIfChange("kafka_version", func(_ context.Context, old, new, meta interface{}) bool {
 				return semver.LessThan(new.(string), old.(string))
 			}),
+			// Brokers can't be converted between Standard and Express.
+			customdiff.ForceNewIfChange("broker_node_group_info.0.instance_type", func(_ context.Context, old, new, meta interface{}) bool {
+				return old.(string) != "" && brokerTypeForInstanceType(old.(string)) != brokerTypeForInstanceType(new.(string))
+			}),
+			resourceClusterCustomizeDiff,
 			verify.SetTagsDiff,
 		),
 
@@ -553,6 +561,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 	var vpcConnectivity *types.VpcConnectivity
 	if v, ok := d.GetOk("broker_node_group_info"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.BrokerNodeGroupInfo = expandBrokerNodeGroupInfo(v.([]interface{})[0].(map[string]interface{}))
+		// Express brokers have no EBS storage.
+		if input.BrokerNodeGroupInfo != nil && brokerTypeForInstanceType(aws.ToString(input.BrokerNodeGroupInfo.InstanceType)) == brokerTypeExpress {
+			input.BrokerNodeGroupInfo.StorageInfo = nil
+		}
 		// "BadRequestException: When creating a cluster, all vpcConnectivity auth schemes must be disabled (‘enabled’ : false). You can enable auth schemes after the cluster is created"
 		if input.BrokerNodeGroupInfo != nil && input.BrokerNodeGroupInfo.ConnectivityInfo != nil {
 			vpcConnectivity = input.BrokerNodeGroupInfo.ConnectivityInfo.VpcConnectivity
@@ -776,7 +788,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
-	if d.HasChanges("broker_node_group_info.0.storage_info") {
+	if d.HasChanges("broker_node_group_info.0.storage_info") && brokerTypeForInstanceType(d.Get("broker_node_group_info.0.instance_type").(string)) != brokerTypeExpress {
 		input := &kafka.UpdateBrokerStorageInput{
 			ClusterArn:     aws.String(d.Id()),
 			CurrentVersion: aws.String(d.Get("current_version").(string)),
@@ -808,6 +820,31 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("storage_mode") {
+		input := &kafka.UpdateStorageInput{
+			ClusterArn:     aws.String(d.Id()),
+			CurrentVersion: aws.String(d.Get("current_version").(string)),
+			StorageMode:    types.StorageMode(d.Get("storage_mode").(string)),
+		}
+
+		output, err := conn.UpdateStorage(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating MSK Cluster (%s) storage mode: %s", d.Id(), err)
+		}
+
+		clusterOperationARN := aws.ToString(output.ClusterOperationArn)
+
+		if _, err := waitClusterOperationCompleted(ctx, conn, clusterOperationARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for MSK Cluster (%s) operation (%s) complete: %s", d.Id(), clusterOperationARN, err)
+		}
+
+		// refresh the current_version attribute after each update
+		if err := refreshClusterVersion(ctx, d, meta); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	if d.HasChange("number_of_broker_nodes") {
 		input := &kafka.UpdateBrokerCountInput{
 			ClusterArn:                aws.String(d.Id()),
@@ -994,6 +1031,78 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 	return diags
 }
 
+func resourceClusterCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("broker_node_group_info.0.instance_type") {
+		return nil
+	}
+
+	// Storage settings are validated as configured, as they are otherwise computed from the existing cluster.
+	var storageInfo bool
+	if v := diff.GetRawConfig().GetAttr("broker_node_group_info"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+		if v := v.Index(cty.NumberIntVal(0)).GetAttr("storage_info"); !v.IsNull() && (!v.IsKnown() || v.LengthInt() > 0) {
+			storageInfo = true
+		}
+	}
+
+	var storageMode string
+	if v := diff.GetRawConfig().GetAttr("storage_mode"); v.IsKnown() && !v.IsNull() {
+		storageMode = v.AsString()
+	}
+
+	return validateClusterBrokerType(diff.Get("broker_node_group_info.0.instance_type").(string), storageMode, storageInfo)
+}
+
+const (
+	expressInstanceTypePrefix = "express."
+)
+
+var (
+	// https://docs.aws.amazon.com/msk/latest/developerguide/msk-broker-types-express.html
+	expressInstanceTypes = []string{
+		"express.m7g.large",
+		"express.m7g.xlarge",
+		"express.m7g.2xlarge",
+		"express.m7g.4xlarge",
+		"express.m7g.8xlarge",
+		"express.m7g.12xlarge",
+		"express.m7g.16xlarge",
+	}
+)
+
+func brokerTypeForInstanceType(instanceType string) brokerType {
+	if strings.HasPrefix(instanceType, expressInstanceTypePrefix) {
+		return brokerTypeExpress
+	}
+
+	return brokerTypeStandard
+}
+
+// validateClusterBrokerType checks that the broker instance type supports the cluster's storage settings.
+func validateClusterBrokerType(instanceType, storageMode string, storageInfo bool) error {
+	if brokerTypeForInstanceType(instanceType) == brokerTypeExpress {
+		if !slices.Contains(expressInstanceTypes, instanceType) {
+			return fmt.Errorf("%q is not a supported Express broker instance type, must be one of: %s", instanceType, strings.Join(expressInstanceTypes, ", "))
+		}
+
+		if storageInfo {
+			return errors.New("broker_node_group_info.0.storage_info cannot be set for Express brokers")
+		}
+
+		if storageMode == string(types.StorageModeTiered) {
+			return fmt.Errorf("storage_mode %s is not supported for Express brokers", types.StorageModeTiered)
+		}
+
+		return nil
+	}
+
+	// https://docs.aws.amazon.com/msk/latest/developerguide/msk-tiered-storage.html#msk-tiered-storage-constraints
+	if storageMode == string(types.StorageModeTiered) && instanceType == "kafka.t3.small" {
+		return fmt.Errorf("storage_mode %s is not supported for %s brokers", types.StorageModeTiered, instanceType)
+	}
+
+	return nil
+}
+
 func refreshClusterVersion(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).KafkaClient(ctx)
 
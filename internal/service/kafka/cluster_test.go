@@ -18,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/kafka/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -1002,6 +1003,176 @@ func TestAccKafkaCluster_storageMode(t *testing.T) {
 	})
 }
 
+func TestValidateClusterBrokerType(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		instanceType string
+		storageMode  string
+		storageInfo  bool
+		expectError  bool
+	}{
+		{
+			name:         "standard",
+			instanceType: "kafka.m5.large",
+			storageInfo:  true,
+		},
+		{
+			name:         "standard tiered",
+			instanceType: "kafka.m5.large",
+			storageMode:  "TIERED",
+			storageInfo:  true,
+		},
+		{
+			name:         "standard tiered t3.small",
+			instanceType: "kafka.t3.small",
+			storageMode:  "TIERED",
+			expectError:  true,
+		},
+		{
+			name:         "express",
+			instanceType: "express.m7g.large",
+		},
+		{
+			name:         "express unsupported instance type",
+			instanceType: "express.m5.large",
+			expectError:  true,
+		},
+		{
+			name:         "express storage info",
+			instanceType: "express.m7g.large",
+			storageInfo:  true,
+			expectError:  true,
+		},
+		{
+			name:         "express tiered",
+			instanceType: "express.m7g.large",
+			storageMode:  "TIERED",
+			expectError:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfkafka.ValidateClusterBrokerType(testCase.instanceType, testCase.storageMode, testCase.storageInfo)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ValidateClusterBrokerType(%q, %q, %t) error = %v, expectError %t", testCase.instanceType, testCase.storageMode, testCase.storageInfo, err, want)
+			}
+		})
+	}
+}
+
+func TestAccKafkaCluster_storageModeUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var cluster1, cluster2 types.ClusterInfo
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_msk_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KafkaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_storageMode(rName, "LOCAL", "3.6.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster1),
+					resource.TestCheckResourceAttr(resourceName, "storage_mode", "LOCAL"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_storageMode(rName, "TIERED", "3.6.0"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster2),
+					testAccCheckClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "storage_mode", "TIERED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKafkaCluster_expressBrokers(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var cluster1, cluster2 types.ClusterInfo
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_msk_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KafkaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_expressBrokers(rName, "express.m7g.large"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster1),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.instance_type", "express.m7g.large"),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.storage_info.#", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"current_version",
+				},
+			},
+			{
+				Config: testAccClusterConfig_expressBrokers(rName, "express.m7g.xlarge"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster2),
+					testAccCheckClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.instance_type", "express.m7g.xlarge"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKafkaCluster_expressBrokersInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KafkaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterConfig_brokerNodeGroupInfoInstanceType(rName, "express.m7g.large"),
+				ExpectError: regexache.MustCompile(`storage_info cannot be set for Express brokers`),
+			},
+		},
+	})
+}
+
 func TestAccKafkaCluster_loggingInfo(t *testing.T) {
 	ctx := acctest.Context(t)
 	var cluster1, cluster2 types.ClusterInfo
@@ -1967,6 +2138,22 @@ resource "aws_msk_cluster" "test" {
 `, rName, storageMode, kafkaVersion))
 }
 
+func testAccClusterConfig_expressBrokers(rName, instanceType string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_base(rName), fmt.Sprintf(`
+resource "aws_msk_cluster" "test" {
+  cluster_name           = %[1]q
+  kafka_version          = "3.6.0"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = aws_subnet.test[*].id
+    instance_type   = %[2]q
+    security_groups = [aws_security_group.test.id]
+  }
+}
+`, rName, instanceType))
+}
+
 func testAccClusterConfig_numberOfBrokerNodes(rName string, brokerCount int) string {
 	return acctest.ConfigCompose(testAccClusterConfig_base(rName), fmt.Sprintf(`
 resource "aws_msk_cluster" "test" {
@@ -10,6 +10,13 @@ const (
 	clusterOperationStateUpdateInProgress = "UPDATE_IN_PROGRESS"
 )
 
+type brokerType string
+
+const (
+	brokerTypeExpress  brokerType = "EXPRESS"
+	brokerTypeStandard brokerType = "STANDARD"
+)
+
 type publicAccessType string
 
 const (
@@ -20,4 +20,6 @@ var (
 	FindSCRAMSecretsByClusterARN = findSCRAMSecretsByClusterARN
 	FindServerlessClusterByARN   = findServerlessClusterByARN
 	FindVPCConnectionByARN       = findVPCConnectionByARN
+
+	ValidateClusterBrokerType = validateClusterBrokerType
 )
@@ -5,11 +5,13 @@ package kinesis
 
 // Exports for use in tests only.
 var (
-	ResourceResourcePolicy = newResourcePolicyResource
-	ResourceStream         = resourceStream
-	ResourceStreamConsumer = resourceStreamConsumer
+	ResourceResourcePolicy           = newResourcePolicyResource
+	ResourceStream                   = resourceStream
+	ResourceStreamConsumer           = resourceStreamConsumer
+	ResourceStreamConsumersExclusive = resourceStreamConsumersExclusive
 
-	FindResourcePolicyByARN = findResourcePolicyByARN
-	FindStreamByName        = findStreamByName
-	FindStreamConsumerByARN = findStreamConsumerByARN
+	FindResourcePolicyByARN        = findResourcePolicyByARN
+	FindStreamByName               = findStreamByName
+	FindStreamConsumerByARN        = findStreamConsumerByARN
+	FindStreamConsumersByStreamARN = findStreamConsumersByStreamARN
 )
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesis
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_kinesis_limits", name="Limits")
+func dataSourceLimits() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceLimitsRead,
+
+		Schema: map[string]*schema.Schema{
+			"on_demand_stream_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"on_demand_stream_count_limit": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"open_shard_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"shard_limit": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLimitsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisClient(ctx)
+
+	output, err := findLimits(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Kinesis Limits: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("on_demand_stream_count", aws.ToInt32(output.OnDemandStreamCount))
+	d.Set("on_demand_stream_count_limit", aws.ToInt32(output.OnDemandStreamCountLimit))
+	d.Set("open_shard_count", aws.ToInt32(output.OpenShardCount))
+	d.Set("shard_limit", aws.ToInt32(output.ShardLimit))
+
+	return diags
+}
+
+func findLimits(ctx context.Context, conn *kinesis.Client) (*kinesis.DescribeLimitsOutput, error) {
+	input := &kinesis.DescribeLimitsInput{}
+
+	output, err := conn.DescribeLimits(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesis_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKinesisLimitsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_kinesis_limits.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLimitsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "on_demand_stream_count"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "on_demand_stream_count_limit"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "open_shard_count"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "shard_limit"),
+				),
+			},
+		},
+	})
+}
+
+const testAccLimitsDataSourceConfig_basic = `
+data "aws_kinesis_limits" "test" {}
+`
@@ -27,6 +27,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceLimits,
+			TypeName: "aws_kinesis_limits",
+			Name:     "Limits",
+		},
 		{
 			Factory:  DataSourceStream,
 			TypeName: "aws_kinesis_stream",
@@ -54,6 +59,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_kinesis_stream_consumer",
 			Name:     "Stream Consumer",
 		},
+		{
+			Factory:  resourceStreamConsumersExclusive,
+			TypeName: "aws_kinesis_stream_consumers_exclusive",
+			Name:     "Stream Consumers Exclusive",
+		},
 	}
 }
 
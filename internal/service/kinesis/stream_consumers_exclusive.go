@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesis
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Kinesis allows at most 5 consumers per stream to be in the CREATING or DELETING state at once.
+	streamConsumerBatchSize = 5
+)
+
+// @SDKResource("aws_kinesis_stream_consumers_exclusive", name="Stream Consumers Exclusive")
+func resourceStreamConsumersExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStreamConsumersExclusivePut,
+		ReadWithoutTimeout:   resourceStreamConsumersExclusiveRead,
+		UpdateWithoutTimeout: resourceStreamConsumersExclusivePut,
+		DeleteWithoutTimeout: resourceStreamConsumersExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"consumer_arns": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"consumer_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+			names.AttrStreamARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceStreamConsumersExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisClient(ctx)
+
+	streamARN := d.Get(names.AttrStreamARN).(string)
+
+	consumers, err := findStreamConsumersByStreamARN(ctx, conn, streamARN)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Kinesis Stream (%s) consumers: %s", streamARN, err)
+	}
+
+	consumerNames := tfslices.ApplyToAll(consumers, func(v types.Consumer) string {
+		return aws.ToString(v.ConsumerName)
+	})
+	add, remove, _ := flex.DiffSlices(consumerNames, flex.ExpandStringValueSet(d.Get("consumer_names").(*schema.Set)), func(s1, s2 string) bool {
+		return s1 == s2
+	})
+
+	if err := deregisterStreamConsumers(ctx, conn, tfslices.Filter(consumers, func(v types.Consumer) bool {
+		return slices.Contains(remove, aws.ToString(v.ConsumerName))
+	})); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deregistering Kinesis Stream (%s) consumers: %s", streamARN, err)
+	}
+
+	if err := registerStreamConsumers(ctx, conn, streamARN, add); err != nil {
+		return sdkdiag.AppendErrorf(diags, "registering Kinesis Stream (%s) consumers: %s", streamARN, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(streamARN)
+	}
+
+	return append(diags, resourceStreamConsumersExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceStreamConsumersExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisClient(ctx)
+
+	consumers, err := findStreamConsumersByStreamARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kinesis Stream Consumers Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Kinesis Stream Consumers Exclusive (%s): %s", d.Id(), err)
+	}
+
+	consumerARNs := make(map[string]string, len(consumers))
+	for _, v := range consumers {
+		consumerARNs[aws.ToString(v.ConsumerName)] = aws.ToString(v.ConsumerARN)
+	}
+
+	d.Set("consumer_arns", consumerARNs)
+	d.Set("consumer_names", tfslices.ApplyToAll(consumers, func(v types.Consumer) string {
+		return aws.ToString(v.ConsumerName)
+	}))
+	d.Set(names.AttrStreamARN, d.Id())
+
+	return diags
+}
+
+func resourceStreamConsumersExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisClient(ctx)
+
+	consumers, err := findStreamConsumersByStreamARN(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Kinesis Stream (%s) consumers: %s", d.Id(), err)
+	}
+
+	consumerNames := flex.ExpandStringValueSet(d.Get("consumer_names").(*schema.Set))
+
+	log.Printf("[DEBUG] Deregistering Kinesis Stream Consumers Exclusive: %s", d.Id())
+	if err := deregisterStreamConsumers(ctx, conn, tfslices.Filter(consumers, func(v types.Consumer) bool {
+		return slices.Contains(consumerNames, aws.ToString(v.ConsumerName))
+	})); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deregistering Kinesis Stream (%s) consumers: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// findStreamConsumersByStreamARN returns the stream's consumers, excluding any that are being deregistered.
+func findStreamConsumersByStreamARN(ctx context.Context, conn *kinesis.Client, streamARN string) ([]types.Consumer, error) {
+	input := &kinesis.ListStreamConsumersInput{
+		StreamARN: aws.String(streamARN),
+	}
+
+	output, err := findStreamConsumers(ctx, conn, input, func(v *types.Consumer) bool {
+		return v.ConsumerStatus != types.ConsumerStatusDeleting
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func registerStreamConsumers(ctx context.Context, conn *kinesis.Client, streamARN string, consumerNames []string) error {
+	const (
+		timeout = 2 * time.Minute
+	)
+
+	for _, chunk := range tfslices.Chunks(consumerNames, streamConsumerBatchSize) {
+		var consumerARNs []string
+
+		for _, name := range chunk {
+			input := &kinesis.RegisterStreamConsumerInput{
+				ConsumerName: aws.String(name),
+				StreamARN:    aws.String(streamARN),
+			}
+
+			outputRaw, err := tfresource.RetryWhenIsA[*types.LimitExceededException](ctx, timeout, func() (interface{}, error) {
+				return conn.RegisterStreamConsumer(ctx, input)
+			})
+
+			if err != nil {
+				return fmt.Errorf("registering consumer (%s): %w", name, err)
+			}
+
+			consumerARNs = append(consumerARNs, aws.ToString(outputRaw.(*kinesis.RegisterStreamConsumerOutput).Consumer.ConsumerARN))
+		}
+
+		for _, arn := range consumerARNs {
+			if _, err := waitStreamConsumerCreated(ctx, conn, arn); err != nil {
+				return fmt.Errorf("waiting for consumer (%s) create: %w", arn, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func deregisterStreamConsumers(ctx context.Context, conn *kinesis.Client, consumers []types.Consumer) error {
+	const (
+		timeout = 2 * time.Minute
+	)
+
+	for _, chunk := range tfslices.Chunks(consumers, streamConsumerBatchSize) {
+		var consumerARNs []string
+
+		for _, v := range chunk {
+			arn := aws.ToString(v.ConsumerARN)
+			input := &kinesis.DeregisterStreamConsumerInput{
+				ConsumerARN: aws.String(arn),
+			}
+
+			_, err := tfresource.RetryWhenIsA[*types.LimitExceededException](ctx, timeout, func() (interface{}, error) {
+				return conn.DeregisterStreamConsumer(ctx, input)
+			})
+
+			if errs.IsA[*types.ResourceNotFoundException](err) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("deregistering consumer (%s): %w", arn, err)
+			}
+
+			consumerARNs = append(consumerARNs, arn)
+		}
+
+		for _, arn := range consumerARNs {
+			if _, err := waitStreamConsumerDeleted(ctx, conn, arn); err != nil {
+				return fmt.Errorf("waiting for consumer (%s) delete: %w", arn, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesis_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkinesis "github.com/hashicorp/terraform-provider-aws/internal/service/kinesis"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKinesisStreamConsumersExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kinesis_stream_consumers_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStreamConsumersExclusiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamConsumersExclusiveConfig_basic(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStreamConsumersExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrStreamARN, "aws_kinesis_stream.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "consumer_names.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "consumer_names.*", rName+"-0"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "consumer_names.*", rName+"-1"),
+					resource.TestCheckResourceAttr(resourceName, "consumer_arns.%", acctest.Ct2),
+					resource.TestCheckResourceAttrSet(resourceName, fmt.Sprintf("consumer_arns.%s-0", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// More consumers than can be registered concurrently.
+				Config: testAccStreamConsumersExclusiveConfig_basic(rName, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStreamConsumersExclusiveCount(ctx, resourceName, 7),
+					resource.TestCheckResourceAttr(resourceName, "consumer_names.#", "7"),
+					resource.TestCheckResourceAttr(resourceName, "consumer_arns.%", "7"),
+				),
+			},
+			{
+				Config: testAccStreamConsumersExclusiveConfig_basic(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStreamConsumersExclusiveCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "consumer_names.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "consumer_names.*", rName+"-0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKinesisStreamConsumersExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kinesis_stream_consumers_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStreamConsumersExclusiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamConsumersExclusiveConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamConsumersExclusiveCount(ctx, resourceName, 2),
+					testAccCheckStreamConsumersExclusiveRegisterOutOfBand(ctx, resourceName, rName+"-out-of-band"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccStreamConsumersExclusiveConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamConsumersExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "consumer_names.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStreamConsumersExclusiveDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_kinesis_stream_consumers_exclusive" {
+				continue
+			}
+
+			output, err := tfkinesis.FindStreamConsumersByStreamARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) > 0 {
+				return fmt.Errorf("Kinesis Stream (%s) consumers still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckStreamConsumersExclusiveCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisClient(ctx)
+
+		output, err := tfkinesis.FindStreamConsumersByStreamARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("Kinesis Stream (%s) consumer count = %d, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckStreamConsumersExclusiveRegisterOutOfBand(ctx context.Context, n, consumerName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisClient(ctx)
+
+		_, err := conn.RegisterStreamConsumer(ctx, &kinesis.RegisterStreamConsumerInput{
+			ConsumerName: aws.String(consumerName),
+			StreamARN:    aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccStreamConsumersExclusiveConfig_basic(rName string, count int) string {
+	return acctest.ConfigCompose(testAccStreamConsumerConfig_base(rName), fmt.Sprintf(`
+resource "aws_kinesis_stream_consumers_exclusive" "test" {
+  stream_arn     = aws_kinesis_stream.test.arn
+  consumer_names = [for i in range(%[2]d) : "%[1]s-${i}"]
+}
+`, rName, count))
+}
@@ -0,0 +1,369 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	taggingtypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_lambda_code_signing_enforcement", name="Code Signing Enforcement")
+func resourceCodeSigningEnforcement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCodeSigningEnforcementCreate,
+		ReadWithoutTimeout:   resourceCodeSigningEnforcementRead,
+		UpdateWithoutTimeout: resourceCodeSigningEnforcementUpdate,
+		DeleteWithoutTimeout: resourceCodeSigningEnforcementDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"code_signing_config_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"function_names": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"function_tags": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"unenforced_function_names": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		CustomizeDiff: customizeDiffCodeSigningEnforcementFunctions,
+	}
+}
+
+func resourceCodeSigningEnforcementCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	arn := d.Get("code_signing_config_arn").(string)
+
+	if _, err := findCodeSigningConfigByARN(ctx, conn, arn); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lambda Code Signing Enforcement (%s): reading Code Signing Config: %s", arn, err)
+	}
+
+	d.SetId(arn)
+
+	if err := enforceCodeSigningConfig(ctx, meta.(*conns.AWSClient), d.Id(), flex.ExpandStringValueMap(d.Get("function_tags").(map[string]interface{})), nil); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lambda Code Signing Enforcement (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceCodeSigningEnforcementRead(ctx, d, meta)...)
+}
+
+func resourceCodeSigningEnforcementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	_, err := findCodeSigningConfigByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lambda Code Signing Enforcement %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Code Signing Enforcement (%s): %s", d.Id(), err)
+	}
+
+	d.Set("code_signing_config_arn", d.Id())
+
+	// function_tags can't be read from AWS, so after import the enforced functions are unknown until the next apply.
+	if tags := flex.ExpandStringValueMap(d.Get("function_tags").(map[string]interface{})); len(tags) > 0 {
+		matching, using, err := findCodeSigningEnforcementFunctionNames(ctx, meta.(*conns.AWSClient), d.Id(), tags)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Lambda Code Signing Enforcement (%s): %s", d.Id(), err)
+		}
+
+		// Only functions that use the code signing config are recorded, so that a matching function
+		// whose code signing config has been changed or removed outside Terraform is reported as a difference.
+		d.Set("function_names", tfslices.Filter(matching, func(v string) bool {
+			return slices.Contains(using, v)
+		}))
+		d.Set("unenforced_function_names", tfslices.Filter(matching, func(v string) bool {
+			return !slices.Contains(using, v)
+		}))
+	}
+
+	return diags
+}
+
+func resourceCodeSigningEnforcementUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	o, _ := d.GetChange("function_names")
+
+	if err := enforceCodeSigningConfig(ctx, meta.(*conns.AWSClient), d.Id(), flex.ExpandStringValueMap(d.Get("function_tags").(map[string]interface{})), flex.ExpandStringValueSet(o.(*schema.Set))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lambda Code Signing Enforcement (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceCodeSigningEnforcementRead(ctx, d, meta)...)
+}
+
+func resourceCodeSigningEnforcementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	log.Printf("[INFO] Deleting Lambda Code Signing Enforcement: %s", d.Id())
+	for _, name := range flex.ExpandStringValueSet(d.Get("function_names").(*schema.Set)) {
+		if err := removeFunctionCodeSigningConfig(ctx, conn, name, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting Lambda Code Signing Enforcement (%s): %s", d.Id(), err)
+		}
+	}
+
+	return diags
+}
+
+// customizeDiffCodeSigningEnforcementFunctions plans an update when a function matching the tag selector
+// does not use the code signing config, for example because the function was created or tagged after the last apply.
+// Matching functions are found when the resource is read, so no API calls are made here.
+func customizeDiffCodeSigningEnforcementFunctions(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// An empty tag selector would match every tagged function in the account.
+	if d.NewValueKnown("function_tags") && len(d.Get("function_tags").(map[string]interface{})) == 0 {
+		return errors.New("function_tags must contain at least one tag")
+	}
+
+	if d.Id() == "" || d.HasChange("function_tags") || d.Get("unenforced_function_names").(*schema.Set).Len() > 0 {
+		if err := d.SetNewComputed("function_names"); err != nil {
+			return err
+		}
+
+		return d.SetNewComputed("unenforced_function_names")
+	}
+
+	return nil
+}
+
+// enforceCodeSigningConfig sets the code signing config of all zip-packaged functions matching the tag selector.
+// No function is changed if any matching function uses a different code signing config.
+// The code signing config is removed from any of the previously enforced functions that no longer match.
+func enforceCodeSigningConfig(ctx context.Context, client *conns.AWSClient, codeSigningConfigARN string, tags map[string]string, previousFunctionNames []string) error {
+	conn := client.LambdaClient(ctx)
+
+	matching, using, err := findCodeSigningEnforcementFunctionNames(ctx, client, codeSigningConfigARN, tags)
+
+	if err != nil {
+		return err
+	}
+
+	var unenforced, conflicting []string
+
+	for _, name := range matching {
+		if slices.Contains(using, name) {
+			continue
+		}
+
+		output, err := conn.GetFunctionCodeSigningConfig(ctx, &lambda.GetFunctionCodeSigningConfigInput{
+			FunctionName: aws.String(name),
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading Lambda Function (%s) code signing config: %w", name, err)
+		}
+
+		if v := aws.ToString(output.CodeSigningConfigArn); v != "" && v != codeSigningConfigARN {
+			conflicting = append(conflicting, name)
+			continue
+		}
+
+		unenforced = append(unenforced, name)
+	}
+
+	if len(conflicting) > 0 {
+		return fmt.Errorf("Lambda Functions (%s) use a different code signing config", strings.Join(conflicting, ", "))
+	}
+
+	for _, name := range unenforced {
+		input := &lambda.PutFunctionCodeSigningConfigInput{
+			CodeSigningConfigArn: aws.String(codeSigningConfigARN),
+			FunctionName:         aws.String(name),
+		}
+
+		_, err := conn.PutFunctionCodeSigningConfig(ctx, input)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("setting Lambda Function (%s) code signing config: %w", name, err)
+		}
+	}
+
+	for _, name := range previousFunctionNames {
+		if slices.Contains(matching, name) {
+			continue
+		}
+
+		if err := removeFunctionCodeSigningConfig(ctx, conn, name, codeSigningConfigARN); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeFunctionCodeSigningConfig removes the code signing config from a function if the function still uses it.
+func removeFunctionCodeSigningConfig(ctx context.Context, conn *lambda.Client, name, codeSigningConfigARN string) error {
+	output, err := conn.GetFunctionCodeSigningConfig(ctx, &lambda.GetFunctionCodeSigningConfigInput{
+		FunctionName: aws.String(name),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading Lambda Function (%s) code signing config: %w", name, err)
+	}
+
+	if aws.ToString(output.CodeSigningConfigArn) != codeSigningConfigARN {
+		return nil
+	}
+
+	_, err = conn.DeleteFunctionCodeSigningConfig(ctx, &lambda.DeleteFunctionCodeSigningConfigInput{
+		FunctionName: aws.String(name),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting Lambda Function (%s) code signing config: %w", name, err)
+	}
+
+	return nil
+}
+
+// findCodeSigningEnforcementFunctionNames returns the names of the zip-packaged functions that have all the
+// specified tags and the names of the functions that use the code signing config.
+// Container image functions do not support code signing and are ignored.
+// The number of API calls doesn't depend on the number of matching functions, as this runs on every refresh.
+func findCodeSigningEnforcementFunctionNames(ctx context.Context, client *conns.AWSClient, codeSigningConfigARN string, tags map[string]string) ([]string, []string, error) {
+	conn := client.LambdaClient(ctx)
+
+	functionARNs, err := findFunctionARNsByTags(ctx, client.ResourceGroupsTaggingAPIClient(ctx), tags)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var matching []string
+
+	if len(functionARNs) > 0 {
+		pages := lambda.NewListFunctionsPaginator(conn, &lambda.ListFunctionsInput{})
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, nil, fmt.Errorf("listing Lambda Functions: %w", err)
+			}
+
+			for _, v := range page.Functions {
+				if v.PackageType == awstypes.PackageTypeZip && slices.Contains(functionARNs, aws.ToString(v.FunctionArn)) {
+					matching = append(matching, aws.ToString(v.FunctionName))
+				}
+			}
+		}
+	}
+
+	input := &lambda.ListFunctionsByCodeSigningConfigInput{
+		CodeSigningConfigArn: aws.String(codeSigningConfigARN),
+	}
+	var using []string
+
+	pages := lambda.NewListFunctionsByCodeSigningConfigPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("listing Lambda Functions using Code Signing Config (%s): %w", codeSigningConfigARN, err)
+		}
+
+		for _, v := range page.FunctionArns {
+			name, err := getFunctionNameFromARN(v)
+
+			if err != nil {
+				return nil, nil, err
+			}
+
+			using = append(using, name)
+		}
+	}
+
+	return matching, using, nil
+}
+
+func findFunctionARNsByTags(ctx context.Context, conn *resourcegroupstaggingapi.Client, tags map[string]string) ([]string, error) {
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: []string{"lambda:function"},
+	}
+
+	for k, v := range tags {
+		input.TagFilters = append(input.TagFilters, taggingtypes.TagFilter{
+			Key:    aws.String(k),
+			Values: []string{v},
+		})
+	}
+
+	var output []string
+
+	pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("listing tagged Lambda Functions: %w", err)
+		}
+
+		for _, v := range page.ResourceTagMappingList {
+			if v := aws.ToString(v.ResourceARN); !slices.Contains(output, v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaCodeSigningEnforcement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_code_signing_enforcement.test"
+	cscResourceName := "aws_lambda_code_signing_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckSignerSigningProfile(ctx, t, "AWSLambda-SHA384-ECDSA")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCodeSigningConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodeSigningEnforcementConfig_basic(rName, "test1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "code_signing_config_arn", cscResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "function_names.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "function_names.*", "aws_lambda_function.test1", "function_name"),
+					resource.TestCheckResourceAttr(resourceName, "function_tags.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "function_tags.Enforce", "test1"),
+					resource.TestCheckResourceAttr(resourceName, "unenforced_function_names.#", acctest.Ct0),
+					testAccCheckFunctionCodeSigningConfigARN(ctx, "aws_lambda_function.test1", cscResourceName),
+					testAccCheckFunctionCodeSigningConfigARN(ctx, "aws_lambda_function.test2", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"function_names", "function_tags", "unenforced_function_names"},
+			},
+			{
+				Config: testAccCodeSigningEnforcementConfig_basic(rName, "test2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "function_names.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "function_names.*", "aws_lambda_function.test2", "function_name"),
+					resource.TestCheckResourceAttr(resourceName, "function_tags.Enforce", "test2"),
+					resource.TestCheckResourceAttr(resourceName, "unenforced_function_names.#", acctest.Ct0),
+					testAccCheckFunctionCodeSigningConfigARN(ctx, "aws_lambda_function.test1", ""),
+					testAccCheckFunctionCodeSigningConfigARN(ctx, "aws_lambda_function.test2", cscResourceName),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckFunctionCodeSigningConfigARN checks the code signing config of a function.
+// An empty code signing config resource name checks that the function has no code signing config.
+func testAccCheckFunctionCodeSigningConfigARN(ctx context.Context, n, cscResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		var want string
+		if cscResourceName != "" {
+			rs, ok := s.RootModule().Resources[cscResourceName]
+			if !ok {
+				return fmt.Errorf("Not found: %s", cscResourceName)
+			}
+
+			want = rs.Primary.Attributes[names.AttrARN]
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		output, err := conn.GetFunctionCodeSigningConfig(ctx, &lambda.GetFunctionCodeSigningConfigInput{
+			FunctionName: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToString(output.CodeSigningConfigArn); got != want {
+			return fmt.Errorf("Lambda Function (%s) code signing config = %q, want %q", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCodeSigningEnforcementConfig_basic(rName, tagValue string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  statement {
+    effect = "Allow"
+
+    principals {
+      identifiers = ["lambda.amazonaws.com"]
+      type        = "Service"
+    }
+
+    actions = ["sts:AssumeRole"]
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.test.json
+}
+
+resource "aws_signer_signing_profile" "test" {
+  platform_id = "AWSLambda-SHA384-ECDSA"
+}
+
+resource "aws_lambda_code_signing_config" "test" {
+  allowed_publishers {
+    signing_profile_version_arns = [aws_signer_signing_profile.test.version_arn]
+  }
+
+  policies {
+    untrusted_artifact_on_deployment = "Warn"
+  }
+}
+
+resource "aws_lambda_function" "test1" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = "%[1]s-1"
+  role          = aws_iam_role.test.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+
+  tags = {
+    Enforce = "test1"
+  }
+
+  lifecycle {
+    ignore_changes = [code_signing_config_arn]
+  }
+}
+
+resource "aws_lambda_function" "test2" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = "%[1]s-2"
+  role          = aws_iam_role.test.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+
+  tags = {
+    Enforce = "test2"
+  }
+
+  lifecycle {
+    ignore_changes = [code_signing_config_arn]
+  }
+}
+
+resource "aws_lambda_code_signing_enforcement" "test" {
+  code_signing_config_arn = aws_lambda_code_signing_config.test.arn
+
+  function_tags = {
+    Enforce = %[2]q
+  }
+
+  depends_on = [aws_lambda_function.test1, aws_lambda_function.test2]
+}
+`, rName, tagValue)
+}
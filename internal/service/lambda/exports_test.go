@@ -9,6 +9,7 @@ var (
 	ResourceCodeSigningConfig            = resourceCodeSigningConfig
 	ResourceEventSourceMapping           = resourceEventSourceMapping
 	ResourceFunction                     = resourceFunction
+	ResourceFunctionConcurrency          = resourceFunctionConcurrency
 	ResourceFunctionEventInvokeConfig    = resourceFunctionEventInvokeConfig
 	ResourceFunctionURL                  = resourceFunctionURL
 	ResourceInvocation                   = resourceInvocation
@@ -17,21 +18,24 @@ var (
 	ResourcePermission                   = resourcePermission
 	ResourceProvisionedConcurrencyConfig = resourceProvisionedConcurrencyConfig
 
-	FindAliasByTwoPartKey                        = findAliasByTwoPartKey
-	FindCodeSigningConfigByARN                   = findCodeSigningConfigByARN
-	FindEventSourceMappingByID                   = findEventSourceMappingByID
-	FindFunctionByName                           = findFunctionByName
-	FindFunctionEventInvokeConfigByTwoPartKey    = findFunctionEventInvokeConfigByTwoPartKey
-	FindFunctionURLByTwoPartKey                  = findFunctionURLByTwoPartKey
-	FindLayerVersionByTwoPartKey                 = findLayerVersionByTwoPartKey
-	FindLayerVersionPolicyByTwoPartKey           = findLayerVersionPolicyByTwoPartKey
-	FindPolicyStatementByTwoPartKey              = findPolicyStatementByTwoPartKey
-	FindProvisionedConcurrencyConfigByTwoPartKey = findProvisionedConcurrencyConfigByTwoPartKey
-	FindRuntimeManagementConfigByTwoPartKey      = findRuntimeManagementConfigByTwoPartKey
-	FunctionEventInvokeConfigParseResourceID     = functionEventInvokeConfigParseResourceID
-	GetFunctionNameFromARN                       = getFunctionNameFromARN
-	GetQualifierFromAliasOrVersionARN            = getQualifierFromAliasOrVersionARN
-	LayerVersionParseResourceID                  = layerVersionParseResourceID
-	LayerVersionPermissionParseResourceID        = layerVersionPermissionParseResourceID
-	SignerServiceIsAvailable                     = signerServiceIsAvailable
+	FindAliasByTwoPartKey                           = findAliasByTwoPartKey
+	FindCodeSigningConfigByARN                      = findCodeSigningConfigByARN
+	FindEventSourceMappingByID                      = findEventSourceMappingByID
+	FindFunctionByName                              = findFunctionByName
+	FindFunctionConcurrencyByName                   = findFunctionConcurrencyByName
+	FindFunctionEventInvokeConfigByTwoPartKey       = findFunctionEventInvokeConfigByTwoPartKey
+	FindFunctionURLByTwoPartKey                     = findFunctionURLByTwoPartKey
+	FindLayerVersionByTwoPartKey                    = findLayerVersionByTwoPartKey
+	FindLayerVersionPolicyByTwoPartKey              = findLayerVersionPolicyByTwoPartKey
+	FindPolicyStatementByTwoPartKey                 = findPolicyStatementByTwoPartKey
+	FindProvisionedConcurrencyConfigByTwoPartKey    = findProvisionedConcurrencyConfigByTwoPartKey
+	FindProvisionedConcurrencyConfigsByFunctionName = findProvisionedConcurrencyConfigsByFunctionName
+	FindRuntimeManagementConfigByTwoPartKey         = findRuntimeManagementConfigByTwoPartKey
+	FunctionEventInvokeConfigParseResourceID        = functionEventInvokeConfigParseResourceID
+	GetFunctionNameFromARN                          = getFunctionNameFromARN
+	GetQualifierFromAliasOrVersionARN               = getQualifierFromAliasOrVersionARN
+	LayerVersionParseResourceID                     = layerVersionParseResourceID
+	LayerVersionPermissionParseResourceID           = layerVersionPermissionParseResourceID
+	RuntimeDeprecationDates                         = runtimeDeprecationDates
+	SignerServiceIsAvailable                        = signerServiceIsAvailable
 )
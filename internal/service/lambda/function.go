@@ -174,7 +174,7 @@ func resourceFunction() *schema.Resource {
 			"filename": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -213,7 +213,7 @@ func resourceFunction() *schema.Resource {
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -327,7 +327,7 @@ func resourceFunction() *schema.Resource {
 			names.AttrS3Bucket: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
 				RequiredWith: []string{"s3_key"},
 			},
 			"s3_key": {
@@ -338,7 +338,7 @@ func resourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -381,6 +381,12 @@ func resourceFunction() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ExactlyOneOf:  []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
+				ConflictsWith: []string{"source_code_hash"},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			names.AttrTimeout: {
@@ -459,6 +465,8 @@ func resourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			checkImageArchitecture,
+			updateSourceCodeHashFromSourceDir,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -495,6 +503,17 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 			return sdkdiag.AppendErrorf(diags, "reading ZIP file (%s): %s", v, err)
 		}
 
+		input.Code.ZipFile = zipFile
+	} else if v, ok := d.GetOk("source_dir"); ok {
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		zipFile, err := zipSourceDir(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "packaging source directory (%s): %s", v, err)
+		}
+
 		input.Code.ZipFile = zipFile
 	} else if v, ok := d.GetOk("image_uri"); ok {
 		input.Code.ImageUri = aws.String(v.(string))
@@ -671,7 +690,10 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "setting file_system_config: %s", err)
 	}
 	d.Set("handler", function.Handler)
-	if err := d.Set("image_config", flattenImageConfig(function.ImageConfigResponse)); err != nil {
+	// Don't overwrite the last known image configuration if it couldn't be retrieved.
+	if v := function.ImageConfigResponse; v != nil && v.Error != nil {
+		diags = sdkdiag.AppendWarningf(diags, "reading Lambda Function (%s) image configuration: %s: %s", d.Id(), aws.ToString(v.Error.ErrorCode), aws.ToString(v.Error.Message))
+	} else if err := d.Set("image_config", flattenImageConfig(v)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting image_config: %s", err)
 	}
 	if output.Code != nil {
@@ -699,9 +721,6 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("signing_profile_version_arn", function.SigningProfileVersionArn)
 	// Support in-place update of non-refreshable attribute.
 	d.Set(names.AttrSkipDestroy, d.Get(names.AttrSkipDestroy))
-	if err := d.Set("snap_start", flattenSnapStart(function.SnapStart)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting snap_start: %s", err)
-	}
 	d.Set("source_code_hash", d.Get("source_code_hash"))
 	d.Set("source_code_size", function.CodeSize)
 	d.Set(names.AttrTimeout, function.Timeout)
@@ -720,6 +739,7 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "setting vpc_config: %s", err)
 	}
 
+	snapStart := function.SnapStart
 	if hasQualifier {
 		d.Set("qualified_arn", functionARN)
 		d.Set("qualified_invoke_arn", invokeARN(meta.(*conns.AWSClient), functionARN))
@@ -736,9 +756,21 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 		d.Set("qualified_invoke_arn", invokeARN(meta.(*conns.AWSClient), qualifiedARN))
 		d.Set(names.AttrVersion, latest.Version)
 
+		// SnapStart optimization only applies to published versions.
+		if snapStart != nil && latest.SnapStart != nil && aws.ToString(latest.Version) != FunctionVersionLatest {
+			snapStart = &awstypes.SnapStartResponse{
+				ApplyOn:            snapStart.ApplyOn,
+				OptimizationStatus: latest.SnapStart.OptimizationStatus,
+			}
+		}
+
 		setTagsOut(ctx, output.Tags)
 	}
 
+	if err := d.Set("snap_start", flattenSnapStart(snapStart)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting snap_start: %s", err)
+	}
+
 	// Currently, this functionality is only enabled in AWS Commercial partition
 	// and other partitions return ambiguous error codes (e.g. AccessDeniedException
 	// in AWS GovCloud (US)) so we cannot just ignore the error as would typically.
@@ -961,6 +993,17 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				return sdkdiag.AppendErrorf(diags, "reading ZIP file (%s): %s", v, err)
 			}
 
+			input.ZipFile = zipFile
+		} else if v, ok := d.GetOk("source_dir"); ok {
+			conns.GlobalMutexKV.Lock(mutexKey)
+			defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+			zipFile, err := zipSourceDir(v.(string))
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "packaging source directory (%s): %s", v, err)
+			}
+
 			input.ZipFile = zipFile
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			input.ImageUri = aws.String(v.(string))
@@ -1358,6 +1401,7 @@ func needsFunctionCodeUpdate(d sdkv2.ResourceDiffer) bool {
 		d.HasChange("s3_key") ||
 		d.HasChange("s3_object_version") ||
 		d.HasChange("image_uri") ||
+		d.HasChange("source_dir") ||
 		d.HasChange("architectures")
 }
 
@@ -1488,6 +1532,11 @@ func flattenImageConfig(apiObject *awstypes.ImageConfigResponse) []interface{} {
 		return nil
 	}
 
+	// An image configuration without any overrides is equivalent to no image configuration.
+	if len(apiObject.ImageConfig.Command) == 0 && len(apiObject.ImageConfig.EntryPoint) == 0 && aws.ToString(apiObject.ImageConfig.WorkingDirectory) == "" {
+		return nil
+	}
+
 	tfMap["command"] = apiObject.ImageConfig.Command
 	tfMap["entry_point"] = apiObject.ImageConfig.EntryPoint
 	tfMap["working_directory"] = apiObject.ImageConfig.WorkingDirectory
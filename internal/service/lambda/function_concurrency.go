@@ -0,0 +1,390 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_lambda_function_concurrency", name="Function Concurrency")
+func resourceFunctionConcurrency() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFunctionConcurrencyPut,
+		ReadWithoutTimeout:   resourceFunctionConcurrencyRead,
+		UpdateWithoutTimeout: resourceFunctionConcurrencyPut,
+		DeleteWithoutTimeout: resourceFunctionConcurrencyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"function_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"provisioned_concurrency": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provisioned_concurrent_executions": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"qualifier": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			"reserved_concurrent_executions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+		},
+
+		CustomizeDiff: resourceFunctionConcurrencyCustomizeDiff,
+	}
+}
+
+// resourceFunctionConcurrencyCustomizeDiff rejects provisioned_concurrency blocks with duplicate qualifiers.
+func resourceFunctionConcurrencyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	seen := make(map[string]bool)
+
+	for _, tfMapRaw := range d.Get("provisioned_concurrency").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		qualifier := tfMap["qualifier"].(string)
+		if qualifier == "" {
+			continue
+		}
+
+		if seen[qualifier] {
+			return fmt.Errorf("duplicate provisioned_concurrency qualifier: %s", qualifier)
+		}
+		seen[qualifier] = true
+	}
+
+	return nil
+}
+
+func resourceFunctionConcurrencyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	functionName := d.Get("function_name").(string)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		d.SetId(functionName)
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+	deadline := tfresource.NewDeadline(timeout)
+
+	// Configs for qualifiers missing from `provisioned_concurrency` are deleted, so they're listed from the function.
+	configs, err := findProvisionedConcurrencyConfigsByFunctionName(ctx, conn, functionName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) provisioned concurrency configs: %s", functionName, err)
+	}
+
+	have := make(map[string]int32)
+	for _, config := range configs {
+		qualifier, err := getQualifierFromAliasOrVersionARN(aws.ToString(config.FunctionArn))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		have[qualifier] = aws.ToInt32(config.RequestedProvisionedConcurrentExecutions)
+	}
+
+	want := expandFunctionConcurrencyProvisionedConcurrency(d.Get("provisioned_concurrency").(*schema.Set).List())
+
+	// Release capacity first so that it is available to the reserved concurrency
+	// and to the provisioned concurrency configurations that are increased.
+	for qualifier, n := range have {
+		if m, ok := want[qualifier]; !ok {
+			if err := deleteProvisionedConcurrencyConfig(ctx, conn, functionName, qualifier); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		} else if m < n {
+			if err := putProvisionedConcurrencyConfig(ctx, conn, functionName, qualifier, m, deadline.Remaining()); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	output, err := findFunctionConcurrencyByName(ctx, conn, functionName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) concurrency: %s", functionName, err)
+	}
+
+	if v := int32(d.Get("reserved_concurrent_executions").(int)); v >= 0 {
+		if output.ReservedConcurrentExecutions == nil || aws.ToInt32(output.ReservedConcurrentExecutions) != v {
+			_, err := conn.PutFunctionConcurrency(ctx, &lambda.PutFunctionConcurrencyInput{
+				FunctionName:                 aws.String(functionName),
+				ReservedConcurrentExecutions: aws.Int32(v),
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting Lambda Function (%s) concurrency: %s", functionName, err)
+			}
+		}
+	} else if output.ReservedConcurrentExecutions != nil {
+		_, err := conn.DeleteFunctionConcurrency(ctx, &lambda.DeleteFunctionConcurrencyInput{
+			FunctionName: aws.String(functionName),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting Lambda Function (%s) concurrency: %s", functionName, err)
+		}
+	}
+
+	for qualifier, m := range want {
+		if n, ok := have[qualifier]; !ok || m > n {
+			if err := putProvisionedConcurrencyConfig(ctx, conn, functionName, qualifier, m, deadline.Remaining()); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return append(diags, resourceFunctionConcurrencyRead(ctx, d, meta)...)
+}
+
+func resourceFunctionConcurrencyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	output, err := findFunctionConcurrencyByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lambda Function Concurrency (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Function Concurrency (%s): %s", d.Id(), err)
+	}
+
+	configs, err := findProvisionedConcurrencyConfigsByFunctionName(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Function Concurrency (%s) provisioned concurrency configs: %s", d.Id(), err)
+	}
+
+	tfList, err := flattenProvisionedConcurrencyConfigListItems(configs)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.Set("function_name", d.Id())
+	if err := d.Set("provisioned_concurrency", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting provisioned_concurrency: %s", err)
+	}
+	if output.ReservedConcurrentExecutions != nil {
+		d.Set("reserved_concurrent_executions", output.ReservedConcurrentExecutions)
+	} else {
+		d.Set("reserved_concurrent_executions", -1)
+	}
+
+	return diags
+}
+
+func resourceFunctionConcurrencyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	configs, err := findProvisionedConcurrencyConfigsByFunctionName(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Function Concurrency (%s) provisioned concurrency configs: %s", d.Id(), err)
+	}
+
+	for _, config := range configs {
+		qualifier, err := getQualifierFromAliasOrVersionARN(aws.ToString(config.FunctionArn))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if err := deleteProvisionedConcurrencyConfig(ctx, conn, d.Id(), qualifier); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	log.Printf("[INFO] Deleting Lambda Function Concurrency: %s", d.Id())
+	_, err = conn.DeleteFunctionConcurrency(ctx, &lambda.DeleteFunctionConcurrencyInput{
+		FunctionName: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lambda Function Concurrency (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func putProvisionedConcurrencyConfig(ctx context.Context, conn *lambda.Client, functionName, qualifier string, provisionedConcurrentExecutions int32, timeout time.Duration) error {
+	id := fmt.Sprintf("%s:%s", functionName, qualifier)
+
+	_, err := conn.PutProvisionedConcurrencyConfig(ctx, &lambda.PutProvisionedConcurrencyConfigInput{
+		FunctionName:                    aws.String(functionName),
+		ProvisionedConcurrentExecutions: aws.Int32(provisionedConcurrentExecutions),
+		Qualifier:                       aws.String(qualifier),
+	})
+
+	if err != nil {
+		return fmt.Errorf("putting Lambda Provisioned Concurrency Config (%s): %w", id, err)
+	}
+
+	if _, err := waitProvisionedConcurrencyConfigReady(ctx, conn, functionName, qualifier, timeout); err != nil {
+		return fmt.Errorf("waiting for Lambda Provisioned Concurrency Config (%s) ready: %w", id, err)
+	}
+
+	return nil
+}
+
+func deleteProvisionedConcurrencyConfig(ctx context.Context, conn *lambda.Client, functionName, qualifier string) error {
+	id := fmt.Sprintf("%s:%s", functionName, qualifier)
+
+	log.Printf("[INFO] Deleting Lambda Provisioned Concurrency Config: %s", id)
+	_, err := conn.DeleteProvisionedConcurrencyConfig(ctx, &lambda.DeleteProvisionedConcurrencyConfigInput{
+		FunctionName: aws.String(functionName),
+		Qualifier:    aws.String(qualifier),
+	})
+
+	if errs.IsA[*awstypes.ProvisionedConcurrencyConfigNotFoundException](err) || errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting Lambda Provisioned Concurrency Config (%s): %w", id, err)
+	}
+
+	return nil
+}
+
+func findFunctionConcurrencyByName(ctx context.Context, conn *lambda.Client, name string) (*lambda.GetFunctionConcurrencyOutput, error) {
+	input := &lambda.GetFunctionConcurrencyInput{
+		FunctionName: aws.String(name),
+	}
+
+	output, err := conn.GetFunctionConcurrency(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findProvisionedConcurrencyConfigsByFunctionName(ctx context.Context, conn *lambda.Client, name string) ([]awstypes.ProvisionedConcurrencyConfigListItem, error) {
+	input := &lambda.ListProvisionedConcurrencyConfigsInput{
+		FunctionName: aws.String(name),
+	}
+	var output []awstypes.ProvisionedConcurrencyConfigListItem
+
+	pages := lambda.NewListProvisionedConcurrencyConfigsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ProvisionedConcurrencyConfigs...)
+	}
+
+	return output, nil
+}
+
+func expandFunctionConcurrencyProvisionedConcurrency(tfList []interface{}) map[string]int32 {
+	apiObjects := make(map[string]int32)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects[tfMap["qualifier"].(string)] = int32(tfMap["provisioned_concurrent_executions"].(int))
+	}
+
+	return apiObjects
+}
+
+func flattenProvisionedConcurrencyConfigListItems(apiObjects []awstypes.ProvisionedConcurrencyConfigListItem) ([]interface{}, error) {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		qualifier, err := getQualifierFromAliasOrVersionARN(aws.ToString(apiObject.FunctionArn))
+		if err != nil {
+			return nil, err
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"provisioned_concurrent_executions": aws.ToInt32(apiObject.RequestedProvisionedConcurrentExecutions),
+			"qualifier":                         qualifier,
+		})
+	}
+
+	return tfList, nil
+}
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaFunctionConcurrency_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	lambdaFunctionResourceName := "aws_lambda_function.test"
+	resourceName := "aws_lambda_function_concurrency.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionConcurrencyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConcurrencyConfig_basic(rName, 5, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionConcurrencyExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "function_name", lambdaFunctionResourceName, "function_name"),
+					resource.TestCheckResourceAttr(resourceName, "provisioned_concurrency.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "provisioned_concurrency.*", map[string]string{
+						"provisioned_concurrent_executions": acctest.Ct1,
+						"qualifier":                         "blue",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "reserved_concurrent_executions", "5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionConcurrency_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function_concurrency.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionConcurrencyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConcurrencyConfig_basic(rName, 5, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionConcurrencyExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflambda.ResourceFunctionConcurrency(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionConcurrency_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function_concurrency.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionConcurrencyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConcurrencyConfig_basic(rName, 5, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionConcurrencyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "provisioned_concurrency.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "reserved_concurrent_executions", "5"),
+				),
+			},
+			{
+				Config: testAccFunctionConcurrencyConfig_twoAliases(rName, 10, 2, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionConcurrencyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "provisioned_concurrency.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "provisioned_concurrency.*", map[string]string{
+						"provisioned_concurrent_executions": acctest.Ct2,
+						"qualifier":                         "blue",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "provisioned_concurrency.*", map[string]string{
+						"provisioned_concurrent_executions": acctest.Ct1,
+						"qualifier":                         "green",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "reserved_concurrent_executions", "10"),
+				),
+			},
+			{
+				// Shrink the reserved concurrency below the previous provisioned total.
+				Config: testAccFunctionConcurrencyConfig_twoAliases(rName, 3, 1, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionConcurrencyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "provisioned_concurrency.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "reserved_concurrent_executions", acctest.Ct3),
+				),
+			},
+			{
+				Config: testAccFunctionConcurrencyConfig_unreserved(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionConcurrencyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "provisioned_concurrency.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "reserved_concurrent_executions", "-1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFunctionConcurrencyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lambda_function_concurrency" {
+				continue
+			}
+
+			output, err := tflambda.FindFunctionConcurrencyByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if output.ReservedConcurrentExecutions != nil {
+				return fmt.Errorf("Lambda Function Concurrency %s still has reserved concurrency", rs.Primary.ID)
+			}
+
+			configs, err := tflambda.FindProvisionedConcurrencyConfigsByFunctionName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(configs) > 0 {
+				return fmt.Errorf("Lambda Function Concurrency %s still has provisioned concurrency", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFunctionConcurrencyExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		_, err := tflambda.FindFunctionConcurrencyByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccFunctionConcurrencyConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccProvisionedConcurrencyConfigConfig_base(rName), `
+resource "aws_lambda_alias" "blue" {
+  name             = "blue"
+  function_name    = aws_lambda_function.test.function_name
+  function_version = aws_lambda_function.test.version
+}
+
+resource "aws_lambda_alias" "green" {
+  name             = "green"
+  function_name    = aws_lambda_function.test.function_name
+  function_version = aws_lambda_function.test.version
+}
+`)
+}
+
+func testAccFunctionConcurrencyConfig_basic(rName string, reservedConcurrentExecutions, provisionedConcurrentExecutions int) string {
+	return acctest.ConfigCompose(testAccFunctionConcurrencyConfig_base(rName), fmt.Sprintf(`
+resource "aws_lambda_function_concurrency" "test" {
+  function_name                  = aws_lambda_function.test.function_name
+  reserved_concurrent_executions = %[1]d
+
+  provisioned_concurrency {
+    qualifier                         = aws_lambda_alias.blue.name
+    provisioned_concurrent_executions = %[2]d
+  }
+}
+`, reservedConcurrentExecutions, provisionedConcurrentExecutions))
+}
+
+func testAccFunctionConcurrencyConfig_twoAliases(rName string, reservedConcurrentExecutions, blueProvisionedConcurrentExecutions, greenProvisionedConcurrentExecutions int) string {
+	return acctest.ConfigCompose(testAccFunctionConcurrencyConfig_base(rName), fmt.Sprintf(`
+resource "aws_lambda_function_concurrency" "test" {
+  function_name                  = aws_lambda_function.test.function_name
+  reserved_concurrent_executions = %[1]d
+
+  provisioned_concurrency {
+    qualifier                         = aws_lambda_alias.blue.name
+    provisioned_concurrent_executions = %[2]d
+  }
+
+  provisioned_concurrency {
+    qualifier                         = aws_lambda_alias.green.name
+    provisioned_concurrent_executions = %[3]d
+  }
+}
+`, reservedConcurrentExecutions, blueProvisionedConcurrentExecutions, greenProvisionedConcurrentExecutions))
+}
+
+func testAccFunctionConcurrencyConfig_unreserved(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionConcurrencyConfig_base(rName), `
+resource "aws_lambda_function_concurrency" "test" {
+  function_name = aws_lambda_function.test.function_name
+}
+`)
+}
@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	mediaTypeDockerManifest     = "application/vnd.docker.distribution.manifest.v2+json"
+	mediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+	mediaTypeOCIImageIndex      = "application/vnd.oci.image.index.v1+json"
+	mediaTypeOCIImageManifest   = "application/vnd.oci.image.manifest.v1+json"
+
+	imageConfigDownloadTimeout = 30 * time.Second
+)
+
+var (
+	ecrImageURIRegexp = regexache.MustCompile(`^(\d{12})\.dkr\.ecr(?:-fips)?\.([0-9a-z-]+)\.amazonaws\.com(?:\.cn)?/([^:@]+)(?::([^:@]+))?(?:@(sha256:[0-9a-f]{64}))?$`)
+)
+
+type ecrImageURI struct {
+	registryID     string
+	region         string
+	repositoryName string
+	imageTag       string
+	imageDigest    string
+}
+
+// parseECRImageURI parses a private Amazon ECR image URI.
+// ok is false if the URI does not reference a private Amazon ECR repository.
+func parseECRImageURI(uri string) (ecrImageURI, bool) {
+	matches := ecrImageURIRegexp.FindStringSubmatch(uri)
+	if matches == nil {
+		return ecrImageURI{}, false
+	}
+
+	v := ecrImageURI{
+		registryID:     matches[1],
+		region:         matches[2],
+		repositoryName: matches[3],
+		imageTag:       matches[4],
+		imageDigest:    matches[5],
+	}
+
+	if v.imageTag == "" && v.imageDigest == "" {
+		v.imageTag = "latest"
+	}
+
+	return v, true
+}
+
+// imageArchitecture returns the OCI platform architecture corresponding to a Lambda architecture.
+func imageArchitecture(architecture awstypes.Architecture) string {
+	switch architecture {
+	case awstypes.ArchitectureArm64:
+		return "arm64"
+	default:
+		return "amd64"
+	}
+}
+
+// checkImageArchitecture verifies at plan time that a container image in a private
+// Amazon ECR repository can be run on the function's instruction set architecture.
+// Images whose platforms cannot be determined, for example because of missing
+// ECR permissions, are not validated.
+func checkImageArchitecture(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("architectures", "image_uri") {
+		return nil
+	}
+
+	if !d.NewValueKnown("architectures") || !d.NewValueKnown("image_uri") {
+		return nil
+	}
+
+	uri, ok := parseECRImageURI(d.Get("image_uri").(string))
+	if !ok {
+		return nil
+	}
+
+	architecture := awstypes.ArchitectureX8664
+	if v := d.Get("architectures").([]interface{}); len(v) > 0 && v[0] != nil {
+		architecture = awstypes.Architecture(v[0].(string))
+	}
+
+	conn := meta.(*conns.AWSClient).ECRClient(ctx)
+	optFn := func(o *ecr.Options) {
+		o.Region = uri.region
+	}
+
+	architectures, err := findImageArchitectures(ctx, conn, uri, optFn)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to determine architectures of container image (%s), skipping validation: %s", d.Get("image_uri").(string), err)
+		return nil
+	}
+
+	if want := imageArchitecture(architecture); len(architectures) > 0 && !slices.Contains(architectures, want) {
+		return fmt.Errorf("container image (%s) does not support the %s architecture (image architectures: %v)", d.Get("image_uri").(string), architecture, architectures)
+	}
+
+	return nil
+}
+
+// findImageArchitectures returns the Linux architectures supported by a container image in a private Amazon ECR repository.
+func findImageArchitectures(ctx context.Context, conn *ecr.Client, uri ecrImageURI, optFns ...func(*ecr.Options)) ([]string, error) {
+	imageID := ecrtypes.ImageIdentifier{}
+	if uri.imageDigest != "" {
+		imageID.ImageDigest = aws.String(uri.imageDigest)
+	} else {
+		imageID.ImageTag = aws.String(uri.imageTag)
+	}
+
+	input := &ecr.BatchGetImageInput{
+		AcceptedMediaTypes: []string{
+			mediaTypeDockerManifest,
+			mediaTypeDockerManifestList,
+			mediaTypeOCIImageIndex,
+			mediaTypeOCIImageManifest,
+		},
+		ImageIds:       []ecrtypes.ImageIdentifier{imageID},
+		RegistryId:     aws.String(uri.registryID),
+		RepositoryName: aws.String(uri.repositoryName),
+	}
+
+	output, err := conn.BatchGetImage(ctx, input, optFns...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output.Images) == 0 {
+		return nil, fmt.Errorf("image not found")
+	}
+
+	var manifest struct {
+		MediaType string `json:"mediaType"`
+		Config    struct {
+			Digest string `json:"digest"`
+		} `json:"config"`
+		Manifests []struct {
+			Platform struct {
+				Architecture string `json:"architecture"`
+				OS           string `json:"os"`
+			} `json:"platform"`
+		} `json:"manifests"`
+	}
+
+	if err := json.Unmarshal([]byte(aws.ToString(output.Images[0].ImageManifest)), &manifest); err != nil {
+		return nil, err
+	}
+
+	mediaType := aws.ToString(output.Images[0].ImageManifestMediaType)
+	if mediaType == "" {
+		mediaType = manifest.MediaType
+	}
+
+	switch mediaType {
+	case mediaTypeDockerManifestList, mediaTypeOCIImageIndex:
+		var architectures []string
+
+		for _, v := range manifest.Manifests {
+			// Skip attestation manifests, which have an "unknown" platform.
+			if v.Platform.OS == "linux" && !slices.Contains(architectures, v.Platform.Architecture) {
+				architectures = append(architectures, v.Platform.Architecture)
+			}
+		}
+
+		return architectures, nil
+	}
+
+	// A single-platform image. Its architecture is recorded in the image configuration.
+	if manifest.Config.Digest == "" {
+		return nil, fmt.Errorf("image manifest has no configuration")
+	}
+
+	layer, err := conn.GetDownloadUrlForLayer(ctx, &ecr.GetDownloadUrlForLayerInput{
+		LayerDigest:    aws.String(manifest.Config.Digest),
+		RegistryId:     aws.String(uri.registryID),
+		RepositoryName: aws.String(uri.repositoryName),
+	}, optFns...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, aws.ToString(layer.DownloadUrl), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := cleanhttp.DefaultClient()
+	client.Timeout = imageConfigDownloadTimeout
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("downloading image configuration: %s", response.Status)
+	}
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var config struct {
+		Architecture string `json:"architecture"`
+	}
+
+	if err := json.Unmarshal(body, &config); err != nil {
+		return nil, err
+	}
+
+	if config.Architecture == "" {
+		return nil, nil
+	}
+
+	return []string{config.Architecture}, nil
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+)
+
+func TestParseECRImageURI(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		uri      string
+		expected ecrImageURI
+		ok       bool
+	}{
+		"tag": {
+			uri: "123456789012.dkr.ecr.us-west-2.amazonaws.com/my-repo:v1", //lintignore:AWSAT003
+			expected: ecrImageURI{
+				registryID:     "123456789012",
+				region:         "us-west-2", //lintignore:AWSAT003
+				repositoryName: "my-repo",
+				imageTag:       "v1",
+			},
+			ok: true,
+		},
+		"no tag": {
+			uri: "123456789012.dkr.ecr.eu-central-1.amazonaws.com/ns/my-repo", //lintignore:AWSAT003
+			expected: ecrImageURI{
+				registryID:     "123456789012",
+				region:         "eu-central-1", //lintignore:AWSAT003
+				repositoryName: "ns/my-repo",
+				imageTag:       "latest",
+			},
+			ok: true,
+		},
+		"digest": {
+			uri: "123456789012.dkr.ecr.cn-north-1.amazonaws.com.cn/my-repo@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef", //lintignore:AWSAT003
+			expected: ecrImageURI{
+				registryID:     "123456789012",
+				region:         "cn-north-1", //lintignore:AWSAT003
+				repositoryName: "my-repo",
+				imageDigest:    "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+			},
+			ok: true,
+		},
+		"FIPS": {
+			uri: "123456789012.dkr.ecr-fips.us-gov-west-1.amazonaws.com/my-repo:v1", //lintignore:AWSAT003
+			expected: ecrImageURI{
+				registryID:     "123456789012",
+				region:         "us-gov-west-1", //lintignore:AWSAT003
+				repositoryName: "my-repo",
+				imageTag:       "v1",
+			},
+			ok: true,
+		},
+		"public": {
+			uri: "public.ecr.aws/lambda/python:3.12",
+		},
+		"Docker Hub": {
+			uri: "docker.io/library/alpine:latest",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := parseECRImageURI(testCase.uri)
+
+			if ok != testCase.ok {
+				t.Fatalf("ok = %t, want %t", ok, testCase.ok)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %+v, want %+v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestImageArchitecture(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[awstypes.Architecture]string{
+		awstypes.ArchitectureArm64: "arm64",
+		awstypes.ArchitectureX8664: "amd64",
+		awstypes.Architecture(""):  "amd64",
+	}
+
+	for architecture, want := range testCases {
+		if got := imageArchitecture(architecture); got != want {
+			t.Errorf("imageArchitecture(%q) = %s, want %s", architecture, got, want)
+		}
+	}
+}
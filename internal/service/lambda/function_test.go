@@ -6,6 +6,7 @@ package lambda_test
 import (
 	"archive/zip"
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -175,6 +176,73 @@ func TestAccLambdaFunction_unpublishedCodeUpdate(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_sourceDir(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	sourceDir := t.TempDir()
+	if err := testAccCopySourceFile("test-fixtures/lambda_func.js", filepath.Join(sourceDir, "lambda.js")); err != nil {
+		t.Fatal(err)
+	}
+
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+
+	var sourceCodeHash string
+	var timeBeforeUpdate time.Time
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_sourceDir(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "source_dir", sourceDir),
+					resource.TestCheckResourceAttrWith(resourceName, "source_code_hash", func(value string) error {
+						if value == "" {
+							return errors.New("expected source_code_hash to be set")
+						}
+						sourceCodeHash = value
+						return nil
+					}),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := testAccCopySourceFile("test-fixtures/lambda_func_modified.js", filepath.Join(sourceDir, "lambda.js")); err != nil {
+						t.Fatal(err)
+					}
+					timeBeforeUpdate = time.Now()
+				},
+				Config: testAccFunctionConfig_sourceDir(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrWith(resourceName, "source_code_hash", func(value string) error {
+						if value == sourceCodeHash {
+							return fmt.Errorf("expected source_code_hash to change, got %s", value)
+						}
+						return nil
+					}),
+					func(s *terraform.State) error {
+						return testAccCheckAttributeIsDateAfter(s, resourceName, "last_modified", timeBeforeUpdate)
+					},
+				),
+			},
+			{
+				Config:   testAccFunctionConfig_sourceDir(rName, sourceDir),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_codeSigning(t *testing.T) {
 	ctx := acctest.Context(t)
 	if curr := acctest.Region(); !tflambda.SignerServiceIsAvailable(curr) {
@@ -332,7 +400,7 @@ func TestAccLambdaFunction_expectFilenameAndS3Attributes(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccFunctionConfig_noFilenameAndS3Attributes(rName),
-				ExpectError: regexache.MustCompile("one of `filename,image_uri,s3_bucket` must be specified"),
+				ExpectError: regexache.MustCompile("one of `filename,image_uri,s3_bucket,source_dir` must be specified"),
 			},
 		},
 	})
@@ -2079,6 +2147,38 @@ func TestAccLambdaFunction_snapStart(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_snapStartPython(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_snapStartRuntime(rName, string(awstypes.RuntimePython312)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "snap_start.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "snap_start.0.apply_on", string(awstypes.SnapStartApplyOnPublishedVersions)),
+					resource.TestCheckResourceAttr(resourceName, "snap_start.0.optimization_status", string(awstypes.SnapStartOptimizationStatusOn)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filename", "publish"},
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_runtimes(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -2425,6 +2525,15 @@ func testAccCheckAttributeIsDateAfter(s *terraform.State, name string, key strin
 	return nil
 }
 
+func testAccCopySourceFile(src, dst string) error {
+	content, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(dst, content, 0o644)
+}
+
 func testAccCreateZipFromFiles(files map[string]string, zipFile *os.File) error {
 	if err := zipFile.Truncate(0); err != nil {
 		return err
@@ -2583,6 +2692,25 @@ resource "aws_lambda_function" "test" {
 `, rName))
 }
 
+func testAccFunctionConfig_snapStartRuntime(rName, runtime string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "lambda_function.handler"
+  runtime       = %[2]q
+  publish       = true
+
+  snap_start {
+    apply_on = "PublishedVersions"
+  }
+}
+`, rName, runtime))
+}
+
 func testAccFunctionConfig_filename(fileName, rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLambdaBase(rName, rName, rName),
@@ -2598,6 +2726,20 @@ resource "aws_lambda_function" "test" {
 `, fileName, rName))
 }
 
+func testAccFunctionConfig_sourceDir(rName, sourceDir string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  source_dir    = %[2]q
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "lambda.handler"
+  runtime       = "nodejs20.x"
+}
+`, rName, sourceDir))
+}
+
 func testAccFunctionConfig_cscBase(rName string) string {
 	return fmt.Sprintf(`
 data "aws_iam_policy_document" "policy" {
@@ -151,26 +151,8 @@ func resourceFunctionURLCreate(ctx context.Context, d *schema.ResourceData, meta
 	d.SetId(id)
 
 	if authorizationType == awstypes.FunctionUrlAuthTypeNone {
-		input := &lambda.AddPermissionInput{
-			Action:              aws.String("lambda:InvokeFunctionUrl"),
-			FunctionName:        aws.String(name),
-			FunctionUrlAuthType: authorizationType,
-			Principal:           aws.String("*"),
-			StatementId:         aws.String("FunctionURLAllowPublicAccess"),
-		}
-
-		if qualifier != "" {
-			input.Qualifier = aws.String(qualifier)
-		}
-
-		_, err := conn.AddPermission(ctx, input)
-
-		if err != nil {
-			if errs.IsAErrorMessageContains[*awstypes.ResourceConflictException](err, "The statement id (FunctionURLAllowPublicAccess) provided already exists") {
-				log.Printf("[DEBUG] function permission statement 'FunctionURLAllowPublicAccess' already exists.")
-			} else {
-				return sdkdiag.AppendErrorf(diags, "adding Lambda Function URL (%s) permission %s", d.Id(), err)
-			}
+		if err := addFunctionURLPublicAccessPermission(ctx, conn, name, qualifier); err != nil {
+			return sdkdiag.AppendErrorf(diags, "adding Lambda Function URL (%s) permission %s", d.Id(), err)
 		}
 	}
 
@@ -200,8 +182,9 @@ func resourceFunctionURLRead(ctx context.Context, d *schema.ResourceData, meta i
 
 	functionURL := aws.ToString(output.FunctionUrl)
 	d.Set("authorization_type", output.AuthType)
-	if output.Cors != nil {
-		if err := d.Set("cors", []interface{}{flattenCors(output.Cors)}); err != nil {
+	// An empty CORS configuration is returned once all CORS settings have been removed.
+	if tfMap := flattenCors(output.Cors); len(tfMap) > 0 {
+		if err := d.Set("cors", []interface{}{tfMap}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting cors: %s", err)
 		}
 	} else {
@@ -210,7 +193,12 @@ func resourceFunctionURLRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set(names.AttrFunctionARN, output.FunctionArn)
 	d.Set("function_name", name)
 	d.Set("function_url", functionURL)
-	d.Set("invoke_mode", output.InvokeMode)
+	// Function URLs created before response streaming was introduced have no invoke mode.
+	if invokeMode := output.InvokeMode; invokeMode != "" {
+		d.Set("invoke_mode", invokeMode)
+	} else {
+		d.Set("invoke_mode", awstypes.InvokeModeBuffered)
+	}
 	d.Set("qualifier", qualifier)
 
 	// Function URL endpoints have the following format:
@@ -235,18 +223,18 @@ func resourceFunctionURLUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	// Always send the authorization type and invoke mode so that an update that only changes the CORS configuration cannot reset them.
+	authorizationType := awstypes.FunctionUrlAuthType(d.Get("authorization_type").(string))
 	input := &lambda.UpdateFunctionUrlConfigInput{
+		AuthType:     authorizationType,
 		FunctionName: aws.String(name),
+		InvokeMode:   awstypes.InvokeMode(d.Get("invoke_mode").(string)),
 	}
 
 	if qualifier != "" {
 		input.Qualifier = aws.String(qualifier)
 	}
 
-	if d.HasChange("authorization_type") {
-		input.AuthType = awstypes.FunctionUrlAuthType(d.Get("authorization_type").(string))
-	}
-
 	if d.HasChange("cors") {
 		if v, ok := d.GetOk("cors"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 			input.Cors = expandCors(v.([]interface{})[0].(map[string]interface{}))
@@ -255,16 +243,26 @@ func resourceFunctionURLUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
-	if d.HasChange("invoke_mode") {
-		input.InvokeMode = awstypes.InvokeMode(d.Get("invoke_mode").(string))
-	}
-
 	_, err = conn.UpdateFunctionUrlConfig(ctx, input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating Lambda Function URL (%s): %s", d.Id(), err)
 	}
 
+	// Public access is granted only after the function URL requires no authorization,
+	// and revoked only after it requires IAM authorization.
+	if d.HasChange("authorization_type") {
+		if authorizationType == awstypes.FunctionUrlAuthTypeNone {
+			if err := addFunctionURLPublicAccessPermission(ctx, conn, name, qualifier); err != nil {
+				return sdkdiag.AppendErrorf(diags, "adding Lambda Function URL (%s) permission %s", d.Id(), err)
+			}
+		} else {
+			if err := removeFunctionURLPublicAccessPermission(ctx, conn, name, qualifier); err != nil {
+				return sdkdiag.AppendErrorf(diags, "removing Lambda Function URL (%s) permission %s", d.Id(), err)
+			}
+		}
+	}
+
 	return append(diags, resourceFunctionURLRead(ctx, d, meta)...)
 }
 
@@ -331,6 +329,52 @@ func findFunctionURL(ctx context.Context, conn *lambda.Client, input *lambda.Get
 	return output, nil
 }
 
+const (
+	functionURLPublicAccessStatementID = "FunctionURLAllowPublicAccess"
+)
+
+func addFunctionURLPublicAccessPermission(ctx context.Context, conn *lambda.Client, name, qualifier string) error {
+	input := &lambda.AddPermissionInput{
+		Action:              aws.String("lambda:InvokeFunctionUrl"),
+		FunctionName:        aws.String(name),
+		FunctionUrlAuthType: awstypes.FunctionUrlAuthTypeNone,
+		Principal:           aws.String("*"),
+		StatementId:         aws.String(functionURLPublicAccessStatementID),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	_, err := conn.AddPermission(ctx, input)
+
+	if errs.IsAErrorMessageContains[*awstypes.ResourceConflictException](err, fmt.Sprintf("The statement id (%s) provided already exists", functionURLPublicAccessStatementID)) {
+		log.Printf("[DEBUG] function permission statement '%s' already exists.", functionURLPublicAccessStatementID)
+		return nil
+	}
+
+	return err
+}
+
+func removeFunctionURLPublicAccessPermission(ctx context.Context, conn *lambda.Client, name, qualifier string) error {
+	input := &lambda.RemovePermissionInput{
+		FunctionName: aws.String(name),
+		StatementId:  aws.String(functionURLPublicAccessStatementID),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	_, err := conn.RemovePermission(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	return err
+}
+
 const functionURLResourceIDSeparator = "/"
 
 func functionURLCreateResourceID(functionName, qualifier string) string {
@@ -398,27 +442,27 @@ func flattenCors(apiObject *awstypes.Cors) map[string]interface{} {
 
 	tfMap := map[string]interface{}{}
 
-	if v := apiObject.AllowCredentials; v != nil {
+	if v := apiObject.AllowCredentials; v != nil && aws.ToBool(v) {
 		tfMap["allow_credentials"] = aws.ToBool(v)
 	}
 
-	if v := apiObject.AllowHeaders; v != nil {
+	if v := apiObject.AllowHeaders; len(v) > 0 {
 		tfMap["allow_headers"] = v
 	}
 
-	if v := apiObject.AllowMethods; v != nil {
+	if v := apiObject.AllowMethods; len(v) > 0 {
 		tfMap["allow_methods"] = v
 	}
 
-	if v := apiObject.AllowOrigins; v != nil {
+	if v := apiObject.AllowOrigins; len(v) > 0 {
 		tfMap["allow_origins"] = v
 	}
 
-	if v := apiObject.ExposeHeaders; v != nil {
+	if v := apiObject.ExposeHeaders; len(v) > 0 {
 		tfMap["expose_headers"] = v
 	}
 
-	if v := apiObject.MaxAge; v != nil {
+	if v := apiObject.MaxAge; v != nil && aws.ToInt32(v) != 0 {
 		tfMap["max_age"] = aws.ToInt32(v)
 	}
 
@@ -277,6 +277,81 @@ func TestAccLambdaFunctionURL_invokeMode(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunctionURL_authorizationTypeInvokeModeLifecycle(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionUrlConfigOutput
+	resourceName := "aws_lambda_function_url.test"
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_basic_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_basic_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_basic_%s", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccFunctionURLPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionURLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionURLConfig_invokeModeAuthorizationType(funcName, policyName, roleName, "NONE", "RESPONSE_STREAM"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLExists(ctx, resourceName, &conf),
+					testAccCheckFunctionURLPublicAccessPermission(ctx, resourceName, true),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", string(awstypes.FunctionUrlAuthTypeNone)),
+					resource.TestCheckResourceAttr(resourceName, "cors.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "invoke_mode", "RESPONSE_STREAM"),
+				),
+			},
+			{
+				Config: testAccFunctionURLConfig_invokeModeAuthorizationTypeCors(funcName, policyName, roleName, "AWS_IAM", "RESPONSE_STREAM", "https://www.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLExists(ctx, resourceName, &conf),
+					testAccCheckFunctionURLPublicAccessPermission(ctx, resourceName, false),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", string(awstypes.FunctionUrlAuthTypeAwsIam)),
+					resource.TestCheckResourceAttr(resourceName, "cors.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_origins.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_origins.*", "https://www.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.max_age", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "invoke_mode", "RESPONSE_STREAM"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Changing only the CORS configuration must preserve the authorization type and invoke mode.
+				Config: testAccFunctionURLConfig_invokeModeAuthorizationTypeCors(funcName, policyName, roleName, "AWS_IAM", "RESPONSE_STREAM", "https://www.example.org"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLExists(ctx, resourceName, &conf),
+					testAccCheckFunctionURLPublicAccessPermission(ctx, resourceName, false),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", string(awstypes.FunctionUrlAuthTypeAwsIam)),
+					resource.TestCheckResourceAttr(resourceName, "cors.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_origins.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_origins.*", "https://www.example.org"),
+					resource.TestCheckResourceAttr(resourceName, "invoke_mode", "RESPONSE_STREAM"),
+				),
+			},
+			{
+				Config: testAccFunctionURLConfig_invokeModeAuthorizationType(funcName, policyName, roleName, "NONE", "BUFFERED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLExists(ctx, resourceName, &conf),
+					testAccCheckFunctionURLPublicAccessPermission(ctx, resourceName, true),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", string(awstypes.FunctionUrlAuthTypeNone)),
+					resource.TestCheckResourceAttr(resourceName, "cors.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "invoke_mode", "BUFFERED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckFunctionURLExists(ctx context.Context, n string, v *lambda.GetFunctionUrlConfigOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -298,6 +373,37 @@ func testAccCheckFunctionURLExists(ctx context.Context, n string, v *lambda.GetF
 	}
 }
 
+func testAccCheckFunctionURLPublicAccessPermission(ctx context.Context, n string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		_, err := tflambda.FindPolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes["function_name"], "FunctionURLAllowPublicAccess", rs.Primary.Attributes["qualifier"])
+
+		if tfresource.NotFound(err) {
+			if exists {
+				return fmt.Errorf("Lambda Function URL %s public access permission not found", rs.Primary.ID)
+			}
+
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			return fmt.Errorf("Lambda Function URL %s public access permission still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckFunctionURLDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
@@ -524,6 +630,46 @@ resource "aws_lambda_function_url" "test" {
 `, funcName, invokeMode))
 }
 
+func testAccFunctionURLConfig_invokeModeAuthorizationType(funcName, policyName, roleName, authorizationType, invokeMode string) string {
+	return acctest.ConfigCompose(testAccFunctionURLConfig_base(policyName, roleName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+}
+
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  authorization_type = %[2]q
+  invoke_mode        = %[3]q
+}
+`, funcName, authorizationType, invokeMode))
+}
+
+func testAccFunctionURLConfig_invokeModeAuthorizationTypeCors(funcName, policyName, roleName, authorizationType, invokeMode, allowOrigin string) string {
+	return acctest.ConfigCompose(testAccFunctionURLConfig_base(policyName, roleName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+}
+
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  authorization_type = %[2]q
+  invoke_mode        = %[3]q
+
+  cors {
+    allow_origins = [%[4]q]
+  }
+}
+`, funcName, authorizationType, invokeMode, allowOrigin))
+}
+
 func testAccFunctionURLConfig_two(funcName, aliasName, policyName, roleName string) string {
 	return acctest.ConfigCompose(testAccFunctionURLConfig_base(policyName, roleName), fmt.Sprintf(`
 resource "aws_lambda_function" "test" {
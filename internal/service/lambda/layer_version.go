@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -37,7 +38,12 @@ func resourceLayerVersion() *schema.Resource {
 		DeleteWithoutTimeout: resourceLayerVersionDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("reused_version", false)
+				d.Set("skip_publish_if_unchanged", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -122,6 +128,10 @@ func resourceLayerVersion() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"reused_version": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"signing_profile_version_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -132,6 +142,12 @@ func resourceLayerVersion() *schema.Resource {
 				ForceNew: true,
 				Optional: true,
 			},
+			"skip_publish_if_unchanged": {
+				Type:     schema.TypeBool,
+				Default:  false,
+				ForceNew: true,
+				Optional: true,
+			},
 			"source_code_hash": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -164,6 +180,7 @@ func resourceLayerVersionCreate(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "filename or s3_* attributes must be set")
 	}
 
+	var codeSHA256 string
 	var layerContent *awstypes.LayerVersionContentInput
 	if hasFilename {
 		conns.GlobalMutexKV.Lock(mutexLayerKey)
@@ -174,6 +191,7 @@ func resourceLayerVersionCreate(ctx context.Context, d *schema.ResourceData, met
 			return sdkdiag.AppendErrorf(diags, "reading ZIP file (%s): %s", filename, err)
 		}
 
+		codeSHA256 = sourceCodeHash(file)
 		layerContent = &awstypes.LayerVersionContentInput{
 			ZipFile: file,
 		}
@@ -188,6 +206,7 @@ func resourceLayerVersionCreate(ctx context.Context, d *schema.ResourceData, met
 		if versionOk {
 			layerContent.S3ObjectVersion = aws.String(s3ObjectVersion.(string))
 		}
+		codeSHA256 = d.Get("source_code_hash").(string)
 	}
 
 	input := &lambda.PublishLayerVersionInput{
@@ -205,6 +224,22 @@ func resourceLayerVersionCreate(ctx context.Context, d *schema.ResourceData, met
 		input.CompatibleRuntimes = flex.ExpandStringyValueSet[awstypes.Runtime](v.(*schema.Set))
 	}
 
+	if d.Get("skip_publish_if_unchanged").(bool) && codeSHA256 != "" {
+		latest, err := findLatestLayerVersionByName(ctx, conn, layerName)
+
+		switch {
+		case tfresource.NotFound(err):
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading Lambda Layer (%s) latest version: %s", layerName, err)
+		case layerVersionMatches(latest, input, codeSHA256):
+			log.Printf("[INFO] Lambda Layer (%s) content is unchanged, reusing version %d", layerName, latest.Version)
+			d.SetId(aws.ToString(latest.LayerVersionArn))
+			d.Set("reused_version", true)
+
+			return append(diags, resourceLayerVersionRead(ctx, d, meta)...)
+		}
+	}
+
 	output, err := conn.PublishLayerVersion(ctx, input)
 
 	if err != nil {
@@ -212,6 +247,7 @@ func resourceLayerVersionCreate(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	d.SetId(aws.ToString(output.LayerVersionArn))
+	d.Set("reused_version", false)
 
 	return append(diags, resourceLayerVersionRead(ctx, d, meta)...)
 }
@@ -264,11 +300,33 @@ func resourceLayerVersionDelete(ctx context.Context, d *schema.ResourceData, met
 		return diags
 	}
 
+	// A reused version wasn't published by this resource and may be in use by other configurations.
+	if d.Get("reused_version").(bool) {
+		log.Printf("[DEBUG] Retaining reused Lambda Layer Version %q", d.Id())
+		return diags
+	}
+
 	layerName, versionNumber, err := layerVersionParseResourceID(d.Id())
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	// A replacement that was created before this resource is destroyed, e.g. with create_before_destroy,
+	// may have reused this version. Only the latest version can have been reused.
+	if d.Get("skip_publish_if_unchanged").(bool) {
+		latest, err := findLatestLayerVersionByName(ctx, conn, layerName)
+
+		switch {
+		case tfresource.NotFound(err):
+			return diags
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading Lambda Layer (%s) latest version: %s", layerName, err)
+		case aws.ToString(latest.LayerVersionArn) == d.Id():
+			log.Printf("[DEBUG] Retaining latest Lambda Layer Version %q", d.Id())
+			return diags
+		}
+	}
+
 	log.Printf("[INFO] Deleting Lambda Layer Version: %s", d.Id())
 	_, err = conn.DeleteLayerVersion(ctx, &lambda.DeleteLayerVersionInput{
 		LayerName:     aws.String(layerName),
@@ -282,6 +340,24 @@ func resourceLayerVersionDelete(ctx context.Context, d *schema.ResourceData, met
 	return diags
 }
 
+// layerVersionMatches returns whether an existing layer version has the specified content and
+// would otherwise be identical to the layer version published by the specified input.
+func layerVersionMatches(output *lambda.GetLayerVersionOutput, input *lambda.PublishLayerVersionInput, codeSHA256 string) bool {
+	if output.Content == nil || aws.ToString(output.Content.CodeSha256) != codeSHA256 {
+		return false
+	}
+
+	if aws.ToString(output.Description) != aws.ToString(input.Description) || aws.ToString(output.LicenseInfo) != aws.ToString(input.LicenseInfo) {
+		return false
+	}
+
+	return equivalentSets(output.CompatibleArchitectures, input.CompatibleArchitectures) && equivalentSets(output.CompatibleRuntimes, input.CompatibleRuntimes)
+}
+
+func equivalentSets[T comparable](a, b []T) bool {
+	return len(itypes.Set[T](a).Difference(b)) == 0 && len(itypes.Set[T](b).Difference(a)) == 0
+}
+
 func layerVersionParseResourceID(id string) (layerName string, version int64, err error) {
 	v, err := arn.Parse(id)
 	if err != nil {
@@ -307,6 +383,33 @@ func findLayerVersionByTwoPartKey(ctx context.Context, conn *lambda.Client, laye
 	return findLayerVersion(ctx, conn, input)
 }
 
+// findLatestLayerVersionByName returns the most recently published version of the specified layer.
+func findLatestLayerVersionByName(ctx context.Context, conn *lambda.Client, layerName string) (*lambda.GetLayerVersionOutput, error) {
+	input := &lambda.ListLayerVersionsInput{
+		LayerName: aws.String(layerName),
+		MaxItems:  aws.Int32(1),
+	}
+
+	output, err := conn.ListLayerVersions(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.LayerVersions) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return findLayerVersionByTwoPartKey(ctx, conn, layerName, output.LayerVersions[0].Version)
+}
+
 func findLayerVersion(ctx context.Context, conn *lambda.Client, input *lambda.GetLayerVersionInput) (*lambda.GetLayerVersionOutput, error) {
 	output, err := conn.GetLayerVersion(ctx, input)
 
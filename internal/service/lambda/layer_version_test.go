@@ -349,6 +349,55 @@ func TestAccLambdaLayerVersion_skipDestroy(t *testing.T) {
 	})
 }
 
+func TestAccLambdaLayerVersion_skipPublishIfUnchanged(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop, // this purposely leaves dangling resources, since skip_destroy = true
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionConfig_skipPublishIfUnchanged(rName, "test1", "nodejs20.x"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, "aws_lambda_layer_version.test1"),
+					acctest.CheckResourceAttrRegionalARN("aws_lambda_layer_version.test1", names.AttrARN, "lambda", fmt.Sprintf("layer:%s:1", rName)),
+					resource.TestCheckResourceAttr("aws_lambda_layer_version.test1", "reused_version", acctest.CtFalse),
+					resource.TestCheckResourceAttr("aws_lambda_layer_version.test1", "skip_publish_if_unchanged", acctest.CtTrue),
+					resource.TestCheckResourceAttr("aws_lambda_layer_version.test1", names.AttrVersion, acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:            "aws_lambda_layer_version.test1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filename", names.AttrSkipDestroy, "skip_publish_if_unchanged"},
+			},
+			{
+				// A new resource with unchanged content reuses the latest layer version.
+				Config: testAccLayerVersionConfig_skipPublishIfUnchanged(rName, "test2", "nodejs20.x"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, "aws_lambda_layer_version.test2"),
+					acctest.CheckResourceAttrRegionalARN("aws_lambda_layer_version.test2", names.AttrARN, "lambda", fmt.Sprintf("layer:%s:1", rName)),
+					resource.TestCheckResourceAttr("aws_lambda_layer_version.test2", "reused_version", acctest.CtTrue),
+					resource.TestCheckResourceAttr("aws_lambda_layer_version.test2", names.AttrVersion, acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccLayerVersionConfig_skipPublishIfUnchanged(rName, "test2", "python3.12"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, "aws_lambda_layer_version.test2"),
+					acctest.CheckResourceAttrRegionalARN("aws_lambda_layer_version.test2", names.AttrARN, "lambda", fmt.Sprintf("layer:%s:2", rName)),
+					resource.TestCheckResourceAttr("aws_lambda_layer_version.test2", "reused_version", acctest.CtFalse),
+					resource.TestCheckResourceAttr("aws_lambda_layer_version.test2", names.AttrVersion, acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLayerVersionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
@@ -535,3 +584,15 @@ resource "aws_lambda_layer_version" "test" {
 }
 `, rName, compatRuntime)
 }
+
+func testAccLayerVersionConfig_skipPublishIfUnchanged(rName, resourceLabel, compatRuntime string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" %[2]q {
+  filename                  = "test-fixtures/lambdatest.zip"
+  layer_name                = %[1]q
+  compatible_runtimes       = [%[3]q]
+  skip_destroy              = true
+  skip_publish_if_unchanged = true
+}
+`, rName, resourceLabel, compatRuntime)
+}
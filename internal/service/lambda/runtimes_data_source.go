@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// runtimeDeprecationDates are the dates on which Lambda runtimes are, or are scheduled to be, deprecated.
+// See https://docs.aws.amazon.com/lambda/latest/dg/lambda-runtimes.html#runtime-support-policy.
+// Lambda has no API that returns this information. Every runtime known to the AWS SDK must have an entry,
+// with a zero value if no deprecation has been scheduled, so that the table is revisited whenever the SDK adds a runtime.
+var runtimeDeprecationDates = map[awstypes.Runtime]time.Time{
+	awstypes.RuntimeDotnet6:        time.Date(2024, time.December, 20, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeDotnet8:        {},
+	awstypes.RuntimeDotnetcore10:   time.Date(2019, time.July, 30, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeDotnetcore20:   time.Date(2019, time.May, 30, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeDotnetcore21:   time.Date(2022, time.January, 5, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeDotnetcore31:   time.Date(2023, time.April, 3, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeGo1x:           time.Date(2024, time.January, 8, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeJava11:         time.Date(2026, time.June, 30, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeJava17:         {},
+	awstypes.RuntimeJava21:         {},
+	awstypes.RuntimeJava8:          time.Date(2024, time.January, 8, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeJava8al2:       time.Date(2026, time.June, 30, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeNodejs:         time.Date(2016, time.October, 31, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeNodejs10x:      time.Date(2021, time.July, 30, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeNodejs12x:      time.Date(2023, time.March, 31, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeNodejs14x:      time.Date(2023, time.December, 4, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeNodejs16x:      time.Date(2024, time.June, 12, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeNodejs18x:      time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeNodejs20x:      time.Date(2026, time.April, 30, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeNodejs43:       time.Date(2020, time.March, 5, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeNodejs43edge:   time.Date(2020, time.April, 30, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeNodejs610:      time.Date(2019, time.August, 12, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeNodejs810:      time.Date(2020, time.March, 6, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeProvided:       time.Date(2024, time.January, 8, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeProvidedal2:    time.Date(2026, time.June, 30, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeProvidedal2023: {},
+	awstypes.RuntimePython27:       time.Date(2021, time.July, 15, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimePython36:       time.Date(2022, time.July, 18, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimePython37:       time.Date(2023, time.December, 4, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimePython38:       time.Date(2024, time.October, 14, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimePython39:       time.Date(2025, time.December, 15, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimePython310:      {},
+	awstypes.RuntimePython311:      {},
+	awstypes.RuntimePython312:      {},
+	awstypes.RuntimeRuby25:         time.Date(2021, time.July, 30, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeRuby27:         time.Date(2023, time.December, 7, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeRuby32:         time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC),
+	awstypes.RuntimeRuby33:         {},
+}
+
+// @FrameworkDataSource(name="Runtimes")
+func newDataSourceRuntimes(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceRuntimes{}, nil
+}
+
+type dataSourceRuntimes struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceRuntimes) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_lambda_runtimes"
+}
+
+func (d *dataSourceRuntimes) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"deprecated_as_of": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"identifiers": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"include_deprecated": schema.BoolAttribute{
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"runtimes": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[runtimeModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"deprecated": schema.BoolAttribute{
+							Computed: true,
+						},
+						"deprecation_date": schema.StringAttribute{
+							Computed: true,
+						},
+						"identifier": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceRuntimes) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dataSourceRuntimesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Whether a runtime is deprecated is only known relative to an explicit point in time,
+	// so that reading the data source gives the same result regardless of when it is read.
+	var asOf time.Time
+	if !data.DeprecatedAsOf.IsNull() {
+		v, diags := data.DeprecatedAsOf.ValueRFC3339Time()
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		asOf = v
+	}
+	includeDeprecated := data.IncludeDeprecated.ValueBool()
+
+	runtimes := awstypes.Runtime("").Values()
+	slices.SortFunc(runtimes, func(a, b awstypes.Runtime) int {
+		return strings.Compare(string(a), string(b))
+	})
+
+	var identifiers []string
+	var models []runtimeModel
+
+	for _, runtime := range runtimes {
+		model := runtimeModel{
+			Deprecated:      types.BoolNull(),
+			DeprecationDate: types.StringNull(),
+			Identifier:      types.StringValue(string(runtime)),
+		}
+
+		v := runtimeDeprecationDates[runtime]
+		if !v.IsZero() {
+			model.DeprecationDate = types.StringValue(v.Format(time.DateOnly))
+		}
+		if !asOf.IsZero() {
+			model.Deprecated = types.BoolValue(!v.IsZero() && !asOf.Before(v))
+		}
+
+		if model.Deprecated.ValueBool() && !includeDeprecated {
+			continue
+		}
+
+		identifiers = append(identifiers, string(runtime))
+		models = append(models, model)
+	}
+
+	data.ID = types.StringValue(d.Meta().Region)
+	data.Identifiers = fwflex.FlattenFrameworkStringValueListOfString(ctx, identifiers)
+	data.Runtimes = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, models)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type dataSourceRuntimesModel struct {
+	DeprecatedAsOf    timetypes.RFC3339                             `tfsdk:"deprecated_as_of"`
+	ID                types.String                                  `tfsdk:"id"`
+	Identifiers       fwtypes.ListValueOf[types.String]             `tfsdk:"identifiers"`
+	IncludeDeprecated types.Bool                                    `tfsdk:"include_deprecated"`
+	Runtimes          fwtypes.ListNestedObjectValueOf[runtimeModel] `tfsdk:"runtimes"`
+}
+
+type runtimeModel struct {
+	Deprecated      types.Bool   `tfsdk:"deprecated"`
+	DeprecationDate types.String `tfsdk:"deprecation_date"`
+	Identifier      types.String `tfsdk:"identifier"`
+}
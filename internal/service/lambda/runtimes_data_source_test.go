@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRuntimeDeprecationDates(t *testing.T) {
+	t.Parallel()
+
+	for _, runtime := range awstypes.Runtime("").Values() {
+		if _, ok := tflambda.RuntimeDeprecationDates[runtime]; !ok {
+			t.Errorf("runtime %q has no deprecation date entry", runtime)
+		}
+	}
+}
+
+func TestAccLambdaRuntimesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_lambda_runtimes.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimesDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "identifiers.#", 0),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "identifiers.*", "python3.12"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "identifiers.*", "nodejs16.x"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "runtimes.*", map[string]string{
+						"deprecation_date": "2024-06-12",
+						"identifier":       "nodejs16.x",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaRuntimesDataSource_deprecatedAsOf(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_lambda_runtimes.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimesDataSourceConfig_deprecatedAsOf("2024-06-11T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "identifiers.*", "nodejs16.x"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "runtimes.*", map[string]string{
+						"deprecated": acctest.CtFalse,
+						"identifier": "nodejs16.x",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "runtimes.*", map[string]string{
+						"deprecated": acctest.CtFalse,
+						"identifier": "python3.12",
+					}),
+				),
+			},
+			{
+				Config: testAccRuntimesDataSourceConfig_deprecatedAsOf("2024-06-12T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "identifiers.#", 0),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "identifiers.*", "python3.12"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "runtimes.*", map[string]string{
+						"deprecated": acctest.CtFalse,
+						"identifier": "provided.al2023",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaRuntimesDataSource_includeDeprecated(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_lambda_runtimes.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimesDataSourceConfig_includeDeprecated("2024-06-12T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "identifiers.*", "nodejs16.x"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "runtimes.*", map[string]string{
+						"deprecated":       acctest.CtTrue,
+						"deprecation_date": "2024-06-12",
+						"identifier":       "nodejs16.x",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccRuntimesDataSourceConfig_basic = `
+data "aws_lambda_runtimes" "test" {}
+`
+
+func testAccRuntimesDataSourceConfig_deprecatedAsOf(deprecatedAsOf string) string {
+	return fmt.Sprintf(`
+data "aws_lambda_runtimes" "test" {
+  deprecated_as_of = %[1]q
+}
+`, deprecatedAsOf)
+}
+
+func testAccRuntimesDataSourceConfig_includeDeprecated(deprecatedAsOf string) string {
+	return fmt.Sprintf(`
+data "aws_lambda_runtimes" "test" {
+  deprecated_as_of   = %[1]q
+  include_deprecated = true
+}
+`, deprecatedAsOf)
+}
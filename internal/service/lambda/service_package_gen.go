@@ -15,7 +15,12 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newDataSourceRuntimes,
+			Name:    "Runtimes",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -79,6 +84,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_lambda_code_signing_config",
 			Name:     "Code Signing Config",
 		},
+		{
+			Factory:  resourceCodeSigningEnforcement,
+			TypeName: "aws_lambda_code_signing_enforcement",
+			Name:     "Code Signing Enforcement",
+		},
 		{
 			Factory:  resourceEventSourceMapping,
 			TypeName: "aws_lambda_event_source_mapping",
@@ -92,6 +102,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceFunctionConcurrency,
+			TypeName: "aws_lambda_function_concurrency",
+			Name:     "Function Concurrency",
+		},
 		{
 			Factory:  resourceFunctionEventInvokeConfig,
 			TypeName: "aws_lambda_function_event_invoke_config",
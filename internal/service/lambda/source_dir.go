@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// sourceDirModTime is the modification time recorded for every file in a source directory archive.
+// It is the earliest time representable in the MS-DOS date format used by ZIP.
+var sourceDirModTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// zipSourceDir returns a ZIP archive of all regular files in the specified directory.
+// The archive is deterministic: entries are sorted by their slash-separated relative path,
+// modification times are fixed and file modes are normalized to 0644 (or 0755 for executables),
+// so identical directory contents produce an identical archive on every operating system.
+func zipSourceDir(v string) ([]byte, error) {
+	dir, err := homedir.Expand(v)
+	if err != nil {
+		return nil, err
+	}
+
+	type entry struct {
+		name string
+		path string
+	}
+	var entries []entry
+
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, entry{
+			name: filepath.ToSlash(name),
+			path: path,
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("%s contains no files", v)
+	}
+
+	slices.SortFunc(entries, func(a, b entry) int {
+		return strings.Compare(a.name, b.name)
+	})
+
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+
+	for _, entry := range entries {
+		// Follow symbolic links to files, skip anything else that isn't a regular file.
+		info, err := os.Stat(entry.path)
+		if err != nil {
+			return nil, err
+		}
+
+		if !info.Mode().IsRegular() {
+			continue
+		}
+
+		header := &zip.FileHeader{
+			Name:     entry.name,
+			Method:   zip.Deflate,
+			Modified: sourceDirModTime,
+		}
+		if info.Mode().Perm()&0o111 != 0 {
+			header.SetMode(0o755)
+		} else {
+			header.SetMode(0o644)
+		}
+
+		f, err := w.CreateHeader(header)
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := os.ReadFile(entry.path)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := f.Write(content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// sourceCodeHash returns the Base64-encoded SHA256 hash of the specified deployment package,
+// equivalent to Terraform's filebase64sha256 function.
+func sourceCodeHash(zipFile []byte) string {
+	hash := sha256.Sum256(zipFile)
+
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+// updateSourceCodeHashFromSourceDir packages source_dir at plan time and sets source_code_hash
+// so that a change to the directory's contents results in a code update.
+func updateSourceCodeHashFromSourceDir(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_dir") {
+		return nil
+	}
+
+	v, ok := d.GetOk("source_dir")
+	if !ok {
+		return nil
+	}
+
+	zipFile, err := zipSourceDir(v.(string))
+	if err != nil {
+		return fmt.Errorf("packaging source directory (%s): %w", v, err)
+	}
+
+	if hash := sourceCodeHash(zipFile); d.Get("source_code_hash").(string) != hash {
+		return d.SetNew("source_code_hash", hash)
+	}
+
+	return nil
+}
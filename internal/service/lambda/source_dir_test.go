@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestZipSourceDir(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"index.js":          "exports.handler = async () => {};",
+		"lib/helper.js":     "module.exports = {};",
+		"lib/nested/a.json": "{}",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	zip1, err := zipSourceDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Modification times must not affect the archive.
+	mtime := time.Now().Add(-24 * time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "index.js"), mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+
+	zip2, err := zipSourceDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := sourceCodeHash(zip2), sourceCodeHash(zip1); got != want {
+		t.Errorf("source code hash = %s, want %s", got, want)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(zip1), int64(len(zip1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, f := range r.File {
+		names = append(names, f.Name)
+
+		if got, want := f.Mode().Perm(), os.FileMode(0o644); got != want {
+			t.Errorf("%s mode = %s, want %s", f.Name, got, want)
+		}
+	}
+
+	if want := []string{"index.js", "lib/helper.js", "lib/nested/a.json"}; !slices.Equal(names, want) {
+		t.Errorf("archive entries = %v, want %v", names, want)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "index.js"), []byte("exports.handler = async () => { return 1; };"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	zip3, err := zipSourceDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if sourceCodeHash(zip3) == sourceCodeHash(zip1) {
+		t.Error("expected source code hash to change with file contents")
+	}
+}
+
+func TestZipSourceDir_empty(t *testing.T) {
+	t.Parallel()
+
+	if _, err := zipSourceDir(t.TempDir()); err == nil {
+		t.Error("expected error for empty directory")
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudwatch_log_delivery_destination", name="Delivery Destination")
+// @Tags(identifierAttribute="arn")
+func resourceDeliveryDestination() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeliveryDestinationPut,
+		ReadWithoutTimeout:   resourceDeliveryDestinationRead,
+		UpdateWithoutTimeout: resourceDeliveryDestinationUpdate,
+		DeleteWithoutTimeout: resourceDeliveryDestinationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delivery_destination_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_resource_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"delivery_destination_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 60),
+					validation.StringMatch(regexache.MustCompile(`^[\w-]*$`), ""),
+				),
+			},
+			"output_format": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.OutputFormat](),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDeliveryDestinationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &cloudwatchlogs.PutDeliveryDestinationInput{
+		DeliveryDestinationConfiguration: expandDeliveryDestinationConfiguration(d.Get("delivery_destination_configuration").([]interface{})),
+		Name:                             aws.String(name),
+	}
+
+	if v, ok := d.GetOk("output_format"); ok {
+		input.OutputFormat = types.OutputFormat(v.(string))
+	}
+
+	if d.IsNewResource() {
+		input.Tags = getTagsIn(ctx)
+	}
+
+	output, err := conn.PutDeliveryDestination(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting CloudWatch Logs Delivery Destination (%s): %s", name, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(aws.ToString(output.DeliveryDestination.Name))
+	}
+
+	return append(diags, resourceDeliveryDestinationRead(ctx, d, meta)...)
+}
+
+func resourceDeliveryDestinationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	destination, err := findDeliveryDestinationByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Logs Delivery Destination (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Logs Delivery Destination (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, destination.Arn)
+	if err := d.Set("delivery_destination_configuration", flattenDeliveryDestinationConfiguration(destination.DeliveryDestinationConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting delivery_destination_configuration: %s", err)
+	}
+	d.Set("delivery_destination_type", destination.DeliveryDestinationType)
+	d.Set(names.AttrName, destination.Name)
+	d.Set("output_format", destination.OutputFormat)
+
+	return diags
+}
+
+func resourceDeliveryDestinationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		return append(diags, resourceDeliveryDestinationPut(ctx, d, meta)...)
+	}
+
+	return append(diags, resourceDeliveryDestinationRead(ctx, d, meta)...)
+}
+
+func resourceDeliveryDestinationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	log.Printf("[INFO] Deleting CloudWatch Logs Delivery Destination: %s", d.Id())
+	_, err := conn.DeleteDeliveryDestination(ctx, &cloudwatchlogs.DeleteDeliveryDestinationInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudWatch Logs Delivery Destination (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDeliveryDestinationByName(ctx context.Context, conn *cloudwatchlogs.Client, name string) (*types.DeliveryDestination, error) {
+	input := &cloudwatchlogs.GetDeliveryDestinationInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetDeliveryDestination(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DeliveryDestination == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DeliveryDestination, nil
+}
+
+func expandDeliveryDestinationConfiguration(tfList []interface{}) *types.DeliveryDestinationConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &types.DeliveryDestinationConfiguration{
+		DestinationResourceArn: aws.String(tfMap["destination_resource_arn"].(string)),
+	}
+}
+
+func flattenDeliveryDestinationConfiguration(apiObject *types.DeliveryDestinationConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"destination_resource_arn": aws.ToString(apiObject.DestinationResourceArn),
+	}}
+}
@@ -6,6 +6,9 @@ package logs
 // Exports for use in tests only.
 var (
 	ResourceDataProtectionPolicy = resourceDataProtectionPolicy
+	ResourceDelivery             = resourceDelivery
+	ResourceDeliveryDestination  = resourceDeliveryDestination
+	ResourceDeliverySource       = resourceDeliverySource
 	ResourceDestination          = resourceDestination
 	ResourceDestinationPolicy    = resourceDestinationPolicy
 	ResourceGroup                = resourceGroup
@@ -15,6 +18,9 @@ var (
 	ResourceStream               = resourceStream
 	ResourceSubscriptionFilter   = resourceSubscriptionFilter
 
+	FindDeliveryByID                   = findDeliveryByID
+	FindDeliveryDestinationByName      = findDeliveryDestinationByName
+	FindDeliverySourceByName           = findDeliverySourceByName
 	FindDestinationByName              = findDestinationByName
 	FindLogGroupByName                 = findLogGroupByName
 	FindLogStreamByTwoPartKey          = findLogStreamByTwoPartKey // nosemgrep:ci.logs-in-var-name
@@ -92,14 +92,16 @@ func resourceBroker() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrID: {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: suppressPendingConfigurationDiffs,
 						},
 						"revision": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Computed: true,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: suppressPendingConfigurationDiffs,
 						},
 					},
 				},
@@ -166,6 +168,11 @@ func resourceBroker() *schema.Resource {
 			names.AttrEngineVersion: {
 				Type:     schema.TypeString,
 				Required: true,
+				DiffSuppressFunc: func(k, o, n string, d *schema.ResourceData) bool {
+					// Suppress differences when the configured engine version is pending,
+					// i.e. the upgrade has been deferred to the next maintenance window.
+					return n != "" && n == d.Get("pending_engine_version").(string)
+				},
 			},
 			"host_instance_type": {
 				Type:     schema.TypeString,
@@ -291,10 +298,30 @@ func resourceBroker() *schema.Resource {
 					},
 				},
 			},
+			"pending_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"revision": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"pending_data_replication_mode": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"pending_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrPubliclyAccessible: {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -490,6 +517,7 @@ func resourceBrokerRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("host_instance_type", output.HostInstanceType)
 	d.Set("instances", flattenBrokerInstances(output.BrokerInstances))
 	d.Set("pending_data_replication_mode", output.PendingDataReplicationMode)
+	d.Set("pending_engine_version", output.PendingEngineVersion)
 	d.Set(names.AttrPubliclyAccessible, output.PubliclyAccessible)
 	d.Set(names.AttrSecurityGroups, output.SecurityGroups)
 	d.Set(names.AttrStorageType, output.StorageType)
@@ -499,6 +527,10 @@ func resourceBrokerRead(ctx context.Context, d *schema.ResourceData, meta interf
 		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
 	}
 
+	if err := d.Set("pending_configuration", flattenPendingConfiguration(output.Configurations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting pending_configuration: %s", err)
+	}
+
 	if err := d.Set("encryption_options", flattenEncryptionOptions(output.EncryptionOptions)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting encryption_options: %s", err)
 	}
@@ -645,6 +677,11 @@ func resourceBrokerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		requiresReboot = true
 	}
 
+	// Changes deferred to the maintenance window are rolled out now when apply_immediately is turned on.
+	if d.HasChange(names.AttrApplyImmediately) && brokerHasPendingChanges(d) {
+		requiresReboot = true
+	}
+
 	if d.Get(names.AttrApplyImmediately).(bool) && requiresReboot {
 		_, err := conn.RebootBroker(ctx, &mq.RebootBrokerInput{
 			BrokerId: aws.String(d.Id()),
@@ -657,9 +694,40 @@ func resourceBrokerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		if _, err := waitBrokerRebooted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for MQ Broker (%s) reboot: %s", d.Id(), err)
 		}
+
+		if _, err := waitBrokerPendingChangesApplied(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for MQ Broker (%s) pending changes apply: %s", d.Id(), err)
+		}
 	}
 
-	return diags
+	return append(diags, resourceBrokerRead(ctx, d, meta)...)
+}
+
+// brokerHasPendingChanges returns whether the broker, as last read, has configuration or engine version changes awaiting a reboot.
+func brokerHasPendingChanges(d *schema.ResourceData) bool {
+	if v := d.Get("pending_engine_version").(string); v != "" {
+		if o, _ := d.GetChange(names.AttrEngineVersion); v != o.(string) {
+			return true
+		}
+	}
+
+	if v, ok := d.GetOk("pending_configuration.0.id"); ok {
+		o, _ := d.GetChange(names.AttrConfiguration)
+		if tfList := o.([]interface{}); len(tfList) > 0 && tfList[0] != nil {
+			tfMap := tfList[0].(map[string]interface{})
+			return v.(string) != tfMap[names.AttrID].(string) || d.Get("pending_configuration.0.revision").(int) != tfMap["revision"].(int)
+		}
+	}
+
+	return false
+}
+
+func suppressPendingConfigurationDiffs(k, o, n string, d *schema.ResourceData) bool {
+	// Suppress differences when the configured value matches the pending configuration,
+	// i.e. the change has been deferred to the next maintenance window.
+	v, ok := d.GetOk("pending_configuration.0." + strings.TrimPrefix(k, "configuration.0."))
+
+	return ok && n != "" && n == fmt.Sprint(v)
 }
 
 func resourceBrokerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -781,6 +849,54 @@ func waitBrokerRebooted(ctx context.Context, conn *mq.Client, id string, timeout
 	return nil, err
 }
 
+const (
+	brokerPendingChangesStatusApplied = "APPLIED"
+	brokerPendingChangesStatusPending = "PENDING"
+)
+
+func statusBrokerPendingChanges(ctx context.Context, conn *mq.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findBrokerByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if v := aws.ToString(output.PendingEngineVersion); v != "" && v != aws.ToString(output.EngineVersion) {
+			return output, brokerPendingChangesStatusPending, nil
+		}
+
+		if v := output.Configurations; v != nil && v.Pending != nil && v.Current != nil {
+			if aws.ToString(v.Pending.Id) != aws.ToString(v.Current.Id) || aws.ToInt32(v.Pending.Revision) != aws.ToInt32(v.Current.Revision) {
+				return output, brokerPendingChangesStatusPending, nil
+			}
+		}
+
+		return output, brokerPendingChangesStatusApplied, nil
+	}
+}
+
+func waitBrokerPendingChangesApplied(ctx context.Context, conn *mq.Client, id string, timeout time.Duration) (*mq.DescribeBrokerOutput, error) {
+	stateConf := retry.StateChangeConf{
+		Pending:    []string{brokerPendingChangesStatusPending},
+		Target:     []string{brokerPendingChangesStatusApplied},
+		Timeout:    timeout,
+		Refresh:    statusBrokerPendingChanges(ctx, conn, id),
+		MinTimeout: 10 * time.Second,
+	}
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mq.DescribeBrokerOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func resourceUserHash(v interface{}) int {
 	var buf bytes.Buffer
 
@@ -1110,6 +1226,19 @@ func flattenConfiguration(config *types.Configurations) []interface{} {
 	return []interface{}{m}
 }
 
+func flattenPendingConfiguration(config *types.Configurations) []interface{} {
+	if config == nil || config.Pending == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		names.AttrID: aws.ToString(config.Pending.Id),
+		"revision":   aws.ToInt32(config.Pending.Revision),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenBrokerInstances(instances []types.BrokerInstance) []interface{} {
 	if len(instances) == 0 {
 		return []interface{}{}
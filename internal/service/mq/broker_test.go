@@ -1041,6 +1041,60 @@ func TestAccMQBroker_Update_engineVersion(t *testing.T) {
 	})
 }
 
+func TestAccMQBroker_Update_engineVersionDeferred(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var broker mq.DescribeBrokerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mq_broker.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerConfig_basic(rName, testAccBrokerVersionOlder),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEngineVersion, testAccBrokerVersionOlder),
+					resource.TestCheckResourceAttr(resourceName, "pending_engine_version", ""),
+				),
+			},
+			{
+				// Without apply_immediately the upgrade is deferred to the maintenance window.
+				Config: testAccBrokerConfig_basic(rName, testAccBrokerVersionNewer),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEngineVersion, testAccBrokerVersionOlder),
+					resource.TestCheckResourceAttr(resourceName, "pending_engine_version", testAccBrokerVersionNewer),
+				),
+			},
+			{
+				Config:   testAccBrokerConfig_basic(rName, testAccBrokerVersionNewer),
+				PlanOnly: true,
+			},
+			{
+				// Turning on apply_immediately reboots the broker to roll out the pending upgrade.
+				Config: testAccBrokerConfig_engineVersionUpdate(rName, testAccBrokerVersionNewer),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEngineVersion, testAccBrokerVersionNewer),
+					resource.TestCheckResourceAttr(resourceName, "pending_engine_version", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccMQBroker_Update_hostInstanceType(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -102,6 +102,40 @@ func TestAccNetworkFirewallTLSInspectionConfiguration_disappears(t *testing.T) {
 	})
 }
 
+func TestAccNetworkFirewallTLSInspectionConfiguration_firewallPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v networkfirewall.DescribeTLSInspectionConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	commonName := acctest.RandomDomain()
+	certificateDomainName := commonName.RandomSubdomain().String()
+	resourceName := "aws_networkfirewall_tls_inspection_configuration.test"
+	policyResourceName := "aws_networkfirewall_firewall_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewall),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTLSInspectionConfigurationConfig_firewallPolicy(rName, commonName.String(), certificateDomainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(policyResourceName, "firewall_policy.0.tls_inspection_configuration_arn", resourceName, names.AttrARN),
+				),
+			},
+			{
+				// Refresh to pick up the association.
+				Config: testAccTLSInspectionConfigurationConfig_firewallPolicy(rName, commonName.String(), certificateDomainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "number_of_associations", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkFirewallTLSInspectionConfiguration_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v networkfirewall.DescribeTLSInspectionConfigurationOutput
@@ -439,6 +473,20 @@ resource "aws_networkfirewall_tls_inspection_configuration" "test" {
 `, rName))
 }
 
+func testAccTLSInspectionConfigurationConfig_firewallPolicy(rName, commonName, certificateDomainName string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_basic(rName, commonName, certificateDomainName), fmt.Sprintf(`
+resource "aws_networkfirewall_firewall_policy" "test" {
+  name = %[1]q
+
+  firewall_policy {
+    stateless_fragment_default_actions = ["aws:drop"]
+    stateless_default_actions          = ["aws:pass"]
+    tls_inspection_configuration_arn   = aws_networkfirewall_tls_inspection_configuration.test.arn
+  }
+}
+`, rName))
+}
+
 func testAccTLSInspectionConfigurationConfig_tags1(rName, commonName, certificateDomainName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_certificateBase(rName, commonName, certificateDomainName), fmt.Sprintf(`
 resource "aws_networkfirewall_tls_inspection_configuration" "test" {
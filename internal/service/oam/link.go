@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
@@ -58,6 +59,43 @@ func ResourceLink() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"link_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_group_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrFilter: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 2000),
+									},
+								},
+							},
+						},
+						"metric_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrFilter: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 2000),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"link_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -104,6 +142,10 @@ func resourceLinkCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		Tags:           getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("link_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.LinkConfiguration = expandLinkConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	out, err := conn.CreateLink(ctx, in)
 	if err != nil {
 		return create.AppendDiagError(diags, names.ObservabilityAccessManager, create.ErrActionCreating, ResNameLink, d.Get("sink_identifier").(string), err)
@@ -137,6 +179,13 @@ func resourceLinkRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	d.Set(names.AttrARN, out.Arn)
 	d.Set("label", out.Label)
 	d.Set("label_template", out.LabelTemplate)
+	if out.LinkConfiguration != nil {
+		if err := d.Set("link_configuration", []interface{}{flattenLinkConfiguration(out.LinkConfiguration)}); err != nil {
+			return create.AppendDiagError(diags, names.ObservabilityAccessManager, create.ErrActionSetting, ResNameLink, d.Id(), err)
+		}
+	} else {
+		d.Set("link_configuration", nil)
+	}
 	d.Set("link_id", out.Id)
 	d.Set("resource_types", flex.FlattenStringValueList(out.ResourceTypes))
 	d.Set("sink_arn", out.SinkArn)
@@ -155,11 +204,20 @@ func resourceLinkUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		Identifier: aws.String(d.Id()),
 	}
 
-	if d.HasChanges("resource_types") {
+	// ResourceTypes is required on every update.
+	if d.HasChanges("link_configuration", "resource_types") {
 		in.ResourceTypes = flex.ExpandStringyValueSet[types.ResourceType](d.Get("resource_types").(*schema.Set))
 		update = true
 	}
 
+	if d.HasChange("link_configuration") {
+		if v, ok := d.GetOk("link_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			in.LinkConfiguration = expandLinkConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			in.LinkConfiguration = &types.LinkConfiguration{}
+		}
+	}
+
 	if update {
 		log.Printf("[DEBUG] Updating ObservabilityAccessManager Link (%s): %#v", d.Id(), in)
 		_, err := conn.UpdateLink(ctx, in)
@@ -216,3 +274,47 @@ func findLinkByID(ctx context.Context, conn *oam.Client, id string) (*oam.GetLin
 
 	return out, nil
 }
+
+func expandLinkConfiguration(tfMap map[string]interface{}) *types.LinkConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.LinkConfiguration{}
+
+	if v, ok := tfMap["log_group_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LogGroupConfiguration = &types.LogGroupConfiguration{
+			Filter: aws.String(v[0].(map[string]interface{})[names.AttrFilter].(string)),
+		}
+	}
+
+	if v, ok := tfMap["metric_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MetricConfiguration = &types.MetricConfiguration{
+			Filter: aws.String(v[0].(map[string]interface{})[names.AttrFilter].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenLinkConfiguration(apiObject *types.LinkConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LogGroupConfiguration; v != nil {
+		tfMap["log_group_configuration"] = []interface{}{map[string]interface{}{
+			names.AttrFilter: aws.ToString(v.Filter),
+		}}
+	}
+
+	if v := apiObject.MetricConfiguration; v != nil {
+		tfMap["metric_configuration"] = []interface{}{map[string]interface{}{
+			names.AttrFilter: aws.ToString(v.Filter),
+		}}
+	}
+
+	return tfMap
+}
@@ -5,8 +5,20 @@ package organizations
 
 import (
 	"time"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 )
 
 const (
 	organizationFinalizationTimeout = 4 * time.Minute
 )
+
+const (
+	// Not yet defined in the AWS SDK for Go v2.
+	policyTypeResourceControlPolicy awstypes.PolicyType = "RESOURCE_CONTROL_POLICY"
+)
+
+func policyTypeValues() []string {
+	return append(enum.Values[awstypes.PolicyType](), string(policyTypeResourceControlPolicy))
+}
@@ -7,12 +7,15 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/organizations"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -68,10 +71,14 @@ func resourceDelegatedAdministrator() *schema.Resource {
 				Computed: true,
 			},
 			"service_principal": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.StringLenBetween(1, 128),
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateDiagFunc: validation.AllDiag(
+					validation.ToDiagFunc(validation.StringLenBetween(1, 128)),
+					validation.ToDiagFunc(validation.StringMatch(regexache.MustCompile(`^[0-9a-z.-]+\.amazonaws\.com$`), "must be an AWS service principal")),
+					validateDelegatedAdministratorServicePrincipal,
+				),
 			},
 			names.AttrStatus: {
 				Type:     schema.TypeString,
@@ -205,6 +212,64 @@ func findDelegatedAdministrators(ctx context.Context, conn *organizations.Client
 	return output, nil
 }
 
+// delegatedAdministratorServicePrincipals returns the service principals of the AWS services
+// known to support delegated administration with AWS Organizations.
+func delegatedAdministratorServicePrincipals() []string {
+	return []string{
+		"access-analyzer.amazonaws.com",
+		"account.amazonaws.com",
+		"auditmanager.amazonaws.com",
+		"backup.amazonaws.com",
+		"cloudtrail.amazonaws.com",
+		"compute-optimizer.amazonaws.com",
+		"config-multiaccountsetup.amazonaws.com",
+		"config.amazonaws.com",
+		"cost-optimization-hub.bcm.amazonaws.com",
+		"detective.amazonaws.com",
+		"devops-guru.amazonaws.com",
+		"fms.amazonaws.com",
+		"guardduty.amazonaws.com",
+		"health.amazonaws.com",
+		"inspector2.amazonaws.com",
+		"ipam.amazonaws.com",
+		"license-manager.amazonaws.com",
+		"license-manager.member-account.amazonaws.com",
+		"macie.amazonaws.com",
+		"member.org.stacksets.cloudformation.amazonaws.com",
+		"networkmanager.amazonaws.com",
+		"reachabilityanalyzer.networkinsights.amazonaws.com",
+		"reporting.trustedadvisor.amazonaws.com",
+		"securityhub.amazonaws.com",
+		"securitylake.amazonaws.com",
+		"servicecatalog.amazonaws.com",
+		"ssm.amazonaws.com",
+		"sso.amazonaws.com",
+		"storage-lens.s3.amazonaws.com",
+	}
+}
+
+// validateDelegatedAdministratorServicePrincipal warns, rather than errors, when the service principal
+// is not known to support delegated administration, as AWS regularly adds support for new services.
+func validateDelegatedAdministratorServicePrincipal(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	value, ok := v.(string)
+	if !ok {
+		return diags
+	}
+
+	if !slices.Contains(delegatedAdministratorServicePrincipals(), value) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Service principal may not support delegated administration",
+			Detail:        fmt.Sprintf("%q is not a known service principal that supports delegated administration with AWS Organizations. Registering the delegated administrator will fail if the service does not support it.", value),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
 const delegatedAdministratorResourceIDSeparator = "/"
 
 func delegatedAdministratorCreateResourceID(accountID, servicePrincipal string) string {
@@ -18,4 +18,6 @@ var (
 	FindPolicyAttachmentByTwoPartKey = findPolicyAttachmentByTwoPartKey
 	FindPolicyByID                   = findPolicyByID
 	FindResourcePolicy               = findResourcePolicy
+
+	PolicyTypeResourceControlPolicy = policyTypeResourceControlPolicy
 )
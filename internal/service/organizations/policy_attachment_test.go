@@ -30,6 +30,7 @@ func testAccPolicyAttachment_Account(t *testing.T) {
 
 	serviceControlPolicyContent := `{"Version": "2012-10-17", "Statement": { "Effect": "Allow", "Action": "*", "Resource": "*"}}`
 	tagPolicyContent := `{ "tags": { "Product": { "tag_key": { "@@assign": "Product" }, "enforced_for": { "@@assign": [ "ec2:instance" ] } } } }`
+	resourceControlPolicyContent := `{"Version": "2012-10-17", "Statement": { "Effect": "Deny", "Principal": "*", "Action": "s3:*", "Resource": "*", "Condition": { "Bool": { "aws:SecureTransport": "false" } } } }`
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOrganizationsAccount(ctx, t) },
@@ -53,6 +54,14 @@ func testAccPolicyAttachment_Account(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "target_id", targetIdResourceName, "master_account_id"),
 				),
 			},
+			{
+				Config: testAccPolicyAttachmentConfig_account(rName, string(tforganizations.PolicyTypeResourceControlPolicy), resourceControlPolicyContent),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyAttachmentExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_id", policyIdResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "target_id", targetIdResourceName, "master_account_id"),
+				),
+			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
@@ -248,7 +257,7 @@ func testAccCheckPolicyAttachmentExists(ctx context.Context, n string) resource.
 func testAccPolicyAttachmentConfig_account(rName, policyType, policyContent string) string {
 	return fmt.Sprintf(`
 resource "aws_organizations_organization" "test" {
-  enabled_policy_types = ["SERVICE_CONTROL_POLICY", "TAG_POLICY"]
+  enabled_policy_types = ["RESOURCE_CONTROL_POLICY", "SERVICE_CONTROL_POLICY", "TAG_POLICY"]
 }
 
 resource "aws_organizations_policy" "test" {
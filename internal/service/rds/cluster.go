@@ -505,6 +505,11 @@ func ResourceCluster() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"snapshot_before_upgrade": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"snapshot_identifier": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -539,6 +544,10 @@ func ResourceCluster() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"upgrade_snapshot_identifier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrVPCSecurityGroupIDs: {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -568,6 +577,16 @@ func ResourceCluster() *schema.Resource {
 				}
 				return nil
 			},
+			func(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+				if diff.Id() == "" {
+					return nil
+				}
+				// A new pre-upgrade snapshot is taken when the engine version changes.
+				if diff.Get("snapshot_before_upgrade").(bool) && diff.HasChange(names.AttrEngineVersion) {
+					return diff.SetNewComputed("upgrade_snapshot_identifier")
+				}
+				return nil
+			},
 		),
 	}
 }
@@ -1297,6 +1316,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		"iam_roles",
 		"replication_source_identifier",
 		"skip_final_snapshot",
+		"snapshot_before_upgrade",
 		names.AttrTags, names.AttrTagsAll) {
 		input := &rds.ModifyDBClusterInput{
 			ApplyImmediately:    aws.Bool(d.Get(names.AttrApplyImmediately).(bool)),
@@ -1445,6 +1465,16 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			}
 		}
 
+		if d.HasChange(names.AttrEngineVersion) && d.Get("snapshot_before_upgrade").(bool) {
+			snapshotID, err := createClusterUpgradeSnapshot(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+			}
+
+			d.Set("upgrade_snapshot_identifier", snapshotID)
+		}
+
 		_, err := tfresource.RetryWhen(ctx, 5*time.Minute,
 			func() (interface{}, error) {
 				return conn.ModifyDBClusterWithContext(ctx, input)
@@ -1638,9 +1668,42 @@ func resourceClusterImport(_ context.Context, d *schema.ResourceData, meta inter
 	// that final_snapshot_identifier is not required
 	d.Set("skip_final_snapshot", true)
 	d.Set("delete_automated_backups", true)
+	d.Set("snapshot_before_upgrade", false)
 	return []*schema.ResourceData{d}, nil
 }
 
+// createClusterUpgradeSnapshot takes a manual snapshot of the specified cluster ahead of an engine version
+// upgrade and waits for it to become available. The snapshot is retained after the upgrade.
+func createClusterUpgradeSnapshot(ctx context.Context, conn *rds.RDS, clusterID string, timeout time.Duration) (string, error) {
+	const (
+		// Snapshot identifiers are limited to 63 characters and "-pre-upgrade-YYYYMMDDHHMMSS" takes 27 of them.
+		clusterIDMaxLen = 36
+	)
+	prefix := clusterID
+	if len(prefix) > clusterIDMaxLen {
+		prefix = strings.TrimRight(prefix[:clusterIDMaxLen], "-")
+	}
+	snapshotID := fmt.Sprintf("%s-pre-upgrade-%s", prefix, time.Now().UTC().Format("20060102150405"))
+	input := &rds.CreateDBClusterSnapshotInput{
+		DBClusterIdentifier:         aws.String(clusterID),
+		DBClusterSnapshotIdentifier: aws.String(snapshotID),
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, clusterSnapshotCreateTimeout, func() (interface{}, error) {
+		return conn.CreateDBClusterSnapshotWithContext(ctx, input)
+	}, rds.ErrCodeInvalidDBClusterStateFault)
+
+	if err != nil {
+		return "", fmt.Errorf("creating RDS DB Cluster Snapshot (%s): %w", snapshotID, err)
+	}
+
+	if _, err := waitDBClusterSnapshotCreated(ctx, conn, snapshotID, timeout); err != nil {
+		return "", fmt.Errorf("waiting for RDS DB Cluster Snapshot (%s) create: %w", snapshotID, err)
+	}
+
+	return snapshotID, nil
+}
+
 func addIAMRoleToCluster(ctx context.Context, conn *rds.RDS, clusterID, roleARN string) error {
 	input := &rds.AddRoleToDBClusterInput{
 		DBClusterIdentifier: aws.String(clusterID),
@@ -112,11 +112,30 @@ const (
 )
 
 const (
-	GlobalClusterStatusAvailable = "available"
-	GlobalClusterStatusCreating  = "creating"
-	GlobalClusterStatusDeleting  = "deleting"
-	GlobalClusterStatusModifying = "modifying"
-	GlobalClusterStatusUpgrading = "upgrading"
+	// instanceStatusPendingModifiedValues is a pseudo-status for an available DB instance that still has pending modified values.
+	instanceStatusPendingModifiedValues = "pending-modified-values"
+)
+
+const (
+	GlobalClusterStatusAvailable     = "available"
+	GlobalClusterStatusCreating      = "creating"
+	GlobalClusterStatusDeleting      = "deleting"
+	GlobalClusterStatusFailingOver   = "failing-over"
+	GlobalClusterStatusModifying     = "modifying"
+	GlobalClusterStatusSwitchingOver = "switching-over"
+	GlobalClusterStatusUpgrading     = "upgrading"
+)
+
+const (
+	dbShardGroupStatusAvailable = "available"
+	dbShardGroupStatusCreating  = "creating"
+	dbShardGroupStatusDeleting  = "deleting"
+	dbShardGroupStatusModifying = "modifying"
+)
+
+const (
+	globalClusterMemberSynchronizationStatusConnected     = "connected"
+	globalClusterMemberSynchronizationStatusPendingResync = "pending-resync"
 )
 
 const (
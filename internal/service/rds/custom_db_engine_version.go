@@ -214,7 +214,7 @@ func resourceCustomDBEngineVersionCreate(ctx context.Context, d *schema.Resource
 
 	output, err := conn.CreateCustomDBEngineVersionWithContext(ctx, &input)
 	if err != nil {
-		return create.AppendDiagError(diags, names.RDS, create.ErrActionCreating, ResNameCustomDBEngineVersion, fmt.Sprintf("%s:%s", aws.StringValue(output.Engine), aws.StringValue(output.EngineVersion)), err)
+		return create.AppendDiagError(diags, names.RDS, create.ErrActionCreating, ResNameCustomDBEngineVersion, fmt.Sprintf("%s:%s", aws.StringValue(input.Engine), aws.StringValue(input.EngineVersion)), err)
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", aws.StringValue(output.Engine), aws.StringValue(output.EngineVersion)))
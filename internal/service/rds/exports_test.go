@@ -6,19 +6,23 @@ package rds
 // Exports for use in tests only.
 var (
 	ResourceEventSubscription       = resourceEventSubscription
+	ResourceIntegration             = newIntegrationResource
 	ResourceProxy                   = resourceProxy
 	ResourceProxyDefaultTargetGroup = resourceProxyDefaultTargetGroup
 	ResourceProxyEndpoint           = resourceProxyEndpoint
 	ResourceProxyTarget             = resourceProxyTarget
+	ResourceShardGroup              = newShardGroupResource
 	ResourceSubnetGroup             = resourceSubnetGroup
 
 	FindDBInstanceByID                         = findDBInstanceByIDSDKv1
 	FindDBProxyByName                          = findDBProxyByName
 	FindDBProxyEndpointByTwoPartKey            = findDBProxyEndpointByTwoPartKey
 	FindDBProxyTargetByFourPartKey             = findDBProxyTargetByFourPartKey
+	FindDBShardGroupByID                       = findDBShardGroupByID
 	FindDBSubnetGroupByName                    = findDBSubnetGroupByName
 	FindDefaultDBProxyTargetGroupByDBProxyName = findDefaultDBProxyTargetGroupByDBProxyName
 	FindEventSubscriptionByID                  = findEventSubscriptionByID
+	FindIntegrationByARN                       = findIntegrationByARN
 	ListTags                                   = listTags
 	NewBlueGreenOrchestrator                   = newBlueGreenOrchestrator
 	ParseDBInstanceARN                         = parseDBInstanceARN
@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_rds_global_cluster_failover", name="Global Cluster Failover")
+func resourceGlobalClusterFailover() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGlobalClusterFailoverCreate,
+		ReadWithoutTimeout:   resourceGlobalClusterFailoverRead,
+		DeleteWithoutTimeout: resourceGlobalClusterFailoverDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allow_data_loss": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"global_cluster_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validIdentifier,
+			},
+			"source_db_cluster_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_db_cluster_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceGlobalClusterFailoverCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+	deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutCreate))
+
+	globalClusterID := d.Get("global_cluster_identifier").(string)
+	targetARN := d.Get("target_db_cluster_arn").(string)
+
+	globalCluster, err := FindGlobalClusterByID(ctx, conn, globalClusterID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS Global Cluster (%s): %s", globalClusterID, err)
+	}
+
+	sourceARN := globalClusterWriterARN(globalCluster)
+	d.Set("source_db_cluster_arn", sourceARN)
+
+	// Nothing to do if the target is already the primary cluster.
+	if sourceARN == targetARN {
+		d.SetId(globalClusterID)
+
+		return append(diags, resourceGlobalClusterFailoverRead(ctx, d, meta)...)
+	}
+
+	if d.Get("allow_data_loss").(bool) {
+		input := &rds.FailoverGlobalClusterInput{
+			AllowDataLoss:             aws.Bool(true),
+			GlobalClusterIdentifier:   aws.String(globalClusterID),
+			TargetDbClusterIdentifier: aws.String(targetARN),
+		}
+
+		_, err = conn.FailoverGlobalClusterWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "failing over RDS Global Cluster (%s) to %s: %s", globalClusterID, targetARN, err)
+		}
+	} else {
+		// A switchover only succeeds once every secondary cluster has caught up with the primary.
+		if _, err := waitGlobalClusterMembersSynchronized(ctx, conn, globalClusterID, deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for RDS Global Cluster (%s) replication lag to drain: %s", globalClusterID, err)
+		}
+
+		input := &rds.SwitchoverGlobalClusterInput{
+			GlobalClusterIdentifier:   aws.String(globalClusterID),
+			TargetDbClusterIdentifier: aws.String(targetARN),
+		}
+
+		_, err = conn.SwitchoverGlobalClusterWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "switching over RDS Global Cluster (%s) to %s: %s", globalClusterID, targetARN, err)
+		}
+	}
+
+	d.SetId(globalClusterID)
+
+	if _, err := waitGlobalClusterFailedOver(ctx, conn, globalClusterID, targetARN, deadline.Remaining()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Global Cluster (%s) failover to %s: %s", globalClusterID, targetARN, err)
+	}
+
+	// After a failover with data loss the old primary may be unreachable and never resynchronize.
+	if !d.Get("allow_data_loss").(bool) {
+		if _, err := waitGlobalClusterMembersSynchronized(ctx, conn, globalClusterID, deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for RDS Global Cluster (%s) replication lag to drain: %s", globalClusterID, err)
+		}
+	}
+
+	return append(diags, resourceGlobalClusterFailoverRead(ctx, d, meta)...)
+}
+
+func resourceGlobalClusterFailoverRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	globalCluster, err := FindGlobalClusterByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS Global Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS Global Cluster (%s): %s", d.Id(), err)
+	}
+
+	d.Set("global_cluster_identifier", globalCluster.GlobalClusterIdentifier)
+	// If the primary cluster has since moved, the next apply will fail back to the configured target.
+	if globalCluster.FailoverState == nil {
+		if writerARN := globalClusterWriterARN(globalCluster); writerARN != "" {
+			d.Set("target_db_cluster_arn", writerARN)
+		}
+	}
+
+	return diags
+}
+
+func resourceGlobalClusterFailoverDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	log.Printf("[WARN] RDS Global Cluster Failover (%s) cannot be undone, removing from state only", d.Id())
+
+	return diags
+}
+
+func globalClusterWriterARN(globalCluster *rds.GlobalCluster) string {
+	for _, v := range globalCluster.GlobalClusterMembers {
+		if aws.BoolValue(v.IsWriter) {
+			return aws.StringValue(v.DBClusterArn)
+		}
+	}
+
+	return ""
+}
+
+func statusGlobalClusterFailover(ctx context.Context, conn *rds.RDS, id, targetARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindGlobalClusterByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if v := output.FailoverState; v != nil {
+			return output, aws.StringValue(v.Status), nil
+		}
+
+		status := aws.StringValue(output.Status)
+
+		// The global cluster can briefly report as available before the new primary is promoted.
+		if status == GlobalClusterStatusAvailable && globalClusterWriterARN(output) != targetARN {
+			return output, GlobalClusterStatusModifying, nil
+		}
+
+		return output, status, nil
+	}
+}
+
+func statusGlobalClusterMembersSynchronization(ctx context.Context, conn *rds.RDS, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindGlobalClusterByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, v := range output.GlobalClusterMembers {
+			if aws.BoolValue(v.IsWriter) {
+				continue
+			}
+
+			if status := aws.StringValue(v.SynchronizationStatus); status != globalClusterMemberSynchronizationStatusConnected {
+				return output, globalClusterMemberSynchronizationStatusPendingResync, nil
+			}
+		}
+
+		return output, globalClusterMemberSynchronizationStatusConnected, nil
+	}
+}
+
+func waitGlobalClusterFailedOver(ctx context.Context, conn *rds.RDS, id, targetARN string, timeout time.Duration) (*rds.GlobalCluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			rds.FailoverStatusPending,
+			rds.FailoverStatusFailingOver,
+			GlobalClusterStatusFailingOver,
+			GlobalClusterStatusModifying,
+			GlobalClusterStatusSwitchingOver,
+		},
+		Target:  []string{GlobalClusterStatusAvailable},
+		Refresh: statusGlobalClusterFailover(ctx, conn, id, targetARN),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.GlobalCluster); ok {
+		if v := output.FailoverState; v != nil && aws.StringValue(v.Status) == rds.FailoverStatusCancelling {
+			tfresource.SetLastError(err, fmt.Errorf("failover from %s to %s cancelled", aws.StringValue(v.FromDbClusterArn), aws.StringValue(v.ToDbClusterArn)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGlobalClusterMembersSynchronized(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.GlobalCluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{globalClusterMemberSynchronizationStatusPendingResync},
+		Target:                    []string{globalClusterMemberSynchronizationStatusConnected},
+		Refresh:                   statusGlobalClusterMembersSynchronization(ctx, conn, id),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.GlobalCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -35,6 +35,7 @@ import (
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -672,6 +673,11 @@ func ResourceInstance() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"wait_for_modifications": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 
 		CustomizeDiff: customdiff.All(
@@ -2000,6 +2006,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		"replicate_source_db",
 		"skip_final_snapshot",
 		names.AttrTags, names.AttrTagsAll,
+		"wait_for_modifications",
 	) {
 		if d.Get("blue_green_update.0.enabled").(bool) && d.HasChangesExcept(
 			names.AttrAllowMajorVersionUpgrade,
@@ -2009,6 +2016,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			"replicate_source_db",
 			"skip_final_snapshot",
 			names.AttrTags, names.AttrTagsAll,
+			"wait_for_modifications",
 			names.AttrDeletionProtection,
 			names.AttrPassword,
 		) {
@@ -2183,6 +2191,13 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): %s", d.Get(names.AttrIdentifier).(string), err)
 			}
+
+			// Without apply_immediately the modifications stay pending until the next maintenance window.
+			if d.Get("wait_for_modifications").(bool) && d.Get(names.AttrApplyImmediately).(bool) {
+				if _, err := waitDBInstanceModificationsApplied(ctx, conn, d.Id(), deadline.Remaining()); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Instance (%s) pending modifications: %s", d.Get(names.AttrIdentifier).(string), err)
+				}
+			}
 		}
 	}
 
@@ -2538,6 +2553,7 @@ func resourceInstanceImport(_ context.Context, d *schema.ResourceData, meta inte
 	// that final_snapshot_identifier is not required.
 	d.Set("skip_final_snapshot", true)
 	d.Set("delete_automated_backups", true)
+	d.Set("wait_for_modifications", false)
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -2834,6 +2850,63 @@ func waitDBInstanceAvailableSDKv2(ctx context.Context, conn *rds_sdkv2.Client, i
 	return nil, err
 }
 
+func statusDBInstancePendingModifiedValues(ctx context.Context, conn *rds_sdkv2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDBInstanceByIDSDKv2(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(output.DBInstanceStatus)
+		if status == InstanceStatusAvailable && !itypes.IsZero(output.PendingModifiedValues) {
+			status = instanceStatusPendingModifiedValues
+		}
+
+		return output, status, nil
+	}
+}
+
+// waitDBInstanceModificationsApplied waits until the DB instance is available and has no pending modified values.
+func waitDBInstanceModificationsApplied(ctx context.Context, conn *rds_sdkv2.Client, id string, timeout time.Duration) (*types.DBInstance, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			InstanceStatusBackingUp,
+			InstanceStatusConfiguringEnhancedMonitoring,
+			InstanceStatusConfiguringIAMDatabaseAuth,
+			InstanceStatusConfiguringLogExports,
+			InstanceStatusMaintenance,
+			InstanceStatusModifying,
+			InstanceStatusMovingToVPC,
+			InstanceStatusRebooting,
+			InstanceStatusRenaming,
+			InstanceStatusResettingMasterCredentials,
+			InstanceStatusStarting,
+			InstanceStatusStopping,
+			InstanceStatusStorageFull,
+			InstanceStatusUpgrading,
+			instanceStatusPendingModifiedValues,
+		},
+		Target:                    []string{InstanceStatusAvailable, InstanceStatusStorageOptimization},
+		Refresh:                   statusDBInstancePendingModifiedValues(ctx, conn, id),
+		Timeout:                   timeout,
+		PollInterval:              30 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitDBInstanceDeleted(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*rds.DBInstance, error) {
 	options := tfresource.Options{
 		PollInterval:              10 * time.Second,
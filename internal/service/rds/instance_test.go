@@ -29,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -4615,6 +4616,55 @@ func TestAccRDSInstance_caCertificateIdentifier(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_waitForModifications(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDBInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_waitForModifications(rName, 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrAllocatedStorage, "20"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_modifications", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					names.AttrApplyImmediately,
+					names.AttrPassword,
+					"skip_final_snapshot",
+					"delete_automated_backups",
+					"wait_for_modifications",
+				},
+			},
+			{
+				Config: testAccInstanceConfig_waitForModifications(rName, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &v),
+					testAccCheckInstanceNoPendingModifiedValues(&v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrAllocatedStorage, "30"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, tfrds.InstanceStatusAvailable),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_RestoreToPointInTime_sourceIdentifier(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -6348,6 +6398,16 @@ func testAccCheckInstanceParameterApplyStatusInSync(dbInstance *rds.DBInstance)
 	}
 }
 
+func testAccCheckInstanceNoPendingModifiedValues(v *rds.DBInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !itypes.IsZero(v.PendingModifiedValues) {
+			return fmt.Errorf("expected DB Instance (%s) to have no pending modified values, got: %s", aws.StringValue(v.DBInstanceIdentifier), v.PendingModifiedValues)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckInstanceReplicaAttributes(source, replica *rds.DBInstance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if replica.ReadReplicaSourceDBInstanceIdentifier != nil && *replica.ReadReplicaSourceDBInstanceIdentifier != *source.DBInstanceIdentifier {
@@ -6933,6 +6993,23 @@ resource "aws_db_instance" "test" {
 `, rName))
 }
 
+func testAccInstanceConfig_waitForModifications(rName string, allocatedStorage int) string {
+	return acctest.ConfigCompose(testAccInstanceConfig_orderableClassMySQL(), fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier             = %[1]q
+  allocated_storage      = %[2]d
+  apply_immediately      = true
+  engine                 = data.aws_rds_orderable_db_instance.test.engine
+  instance_class         = data.aws_rds_orderable_db_instance.test.instance_class
+  db_name                = "test"
+  skip_final_snapshot    = true
+  password               = "avoid-plaintext-passwords"
+  username               = "tfacctest"
+  wait_for_modifications = true
+}
+`, rName, allocatedStorage))
+}
+
 func testAccInstanceConfig_iamAuth(rName string) string {
 	return fmt.Sprintf(`
 data "aws_rds_engine_version" "default" {
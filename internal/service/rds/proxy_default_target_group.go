@@ -93,7 +93,6 @@ func resourceProxyDefaultTargetGroup() *schema.Resource {
 			"db_proxy_name": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validIdentifier,
 			},
 			names.AttrName: {
@@ -127,10 +126,11 @@ func resourceProxyDefaultTargetGroupPut(ctx context.Context, d *schema.ResourceD
 	timeout := d.Timeout(schema.TimeoutUpdate)
 	if d.IsNewResource() {
 		timeout = d.Timeout(schema.TimeoutCreate)
-
-		d.SetId(dbProxyName)
 	}
 
+	// The DB Proxy can be renamed in-place, so follow the new name rather than replacing the default target group.
+	d.SetId(dbProxyName)
+
 	if _, err := waitDefaultDBProxyTargetGroupAvailable(ctx, conn, dbProxyName, timeout); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Proxy Default Target Group (%s) update: %s", d.Id(), err)
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -297,6 +298,49 @@ func TestAccRDSProxyDefaultTargetGroup_sessionPinningFilters(t *testing.T) {
 	})
 }
 
+func TestAccRDSProxyDefaultTargetGroup_proxyName(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbProxyTargetGroup types.DBProxyTargetGroup
+	resourceName := "aws_db_proxy_default_target_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccDBProxyPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProxyTargetGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProxyDefaultTargetGroupConfig_proxyName(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProxyTargetGroupExists(ctx, resourceName, &dbProxyTargetGroup),
+					resource.TestCheckResourceAttr(resourceName, "db_proxy_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.max_connections_percent", "80"),
+				),
+			},
+			{
+				Config: testAccProxyDefaultTargetGroupConfig_proxyName(rName, rNameUpdated),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProxyTargetGroupExists(ctx, resourceName, &dbProxyTargetGroup),
+					resource.TestCheckResourceAttr(resourceName, "db_proxy_name", rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, names.AttrID, rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.max_connections_percent", "80"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSProxyDefaultTargetGroup_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -375,6 +419,10 @@ func testAccCheckProxyTargetGroupExists(ctx context.Context, n string, v *types.
 }
 
 func testAccProxyDefaultTargetGroupConfig_base(rName string) string {
+	return testAccProxyDefaultTargetGroupConfig_baseProxyName(rName, rName)
+}
+
+func testAccProxyDefaultTargetGroupConfig_baseProxyName(rName, proxyName string) string {
 	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
 resource "aws_db_proxy" "test" {
   depends_on = [
@@ -382,7 +430,7 @@ resource "aws_db_proxy" "test" {
     aws_iam_role_policy.test
   ]
 
-  name                   = %[1]q
+  name                   = %[2]q
   debug_logging          = false
   engine_family          = "MYSQL"
   idle_client_timeout    = 1800
@@ -458,7 +506,7 @@ resource "aws_security_group" "test" {
     Name = %[1]q
   }
 }
-`, rName))
+`, rName, proxyName))
 }
 
 func testAccProxyDefaultTargetGroupConfig_basic(rName string) string {
@@ -469,6 +517,18 @@ resource "aws_db_proxy_default_target_group" "test" {
 `)
 }
 
+func testAccProxyDefaultTargetGroupConfig_proxyName(rName, proxyName string) string {
+	return acctest.ConfigCompose(testAccProxyDefaultTargetGroupConfig_baseProxyName(rName, proxyName), `
+resource "aws_db_proxy_default_target_group" "test" {
+  db_proxy_name = aws_db_proxy.test.name
+
+  connection_pool_config {
+    max_connections_percent = 80
+  }
+}
+`)
+}
+
 func testAccProxyDefaultTargetGroupConfig_emptyConnectionPoolConfig(rName string) string {
 	return acctest.ConfigCompose(testAccProxyDefaultTargetGroupConfig_base(rName), `
 resource "aws_db_proxy_default_target_group" "test" {
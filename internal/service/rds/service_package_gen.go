@@ -24,9 +24,20 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newIntegrationResource,
+			Name:    "Integration",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory: newResourceExportTask,
 		},
+		{
+			Factory: newShardGroupResource,
+			Name:    "DB Shard Group",
+		},
 	}
 }
 
@@ -251,6 +262,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceGlobalCluster,
 			TypeName: "aws_rds_global_cluster",
 		},
+		{
+			Factory:  resourceGlobalClusterFailover,
+			TypeName: "aws_rds_global_cluster_failover",
+			Name:     "Global Cluster Failover",
+		},
 		{
 			Factory:  ResourceReservedInstance,
 			TypeName: "aws_rds_reserved_instance",
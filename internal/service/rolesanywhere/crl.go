@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rolesanywhere
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
+	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_rolesanywhere_crl", name="CRL")
+// @Tags(identifierAttribute="arn")
+func ResourceCRL() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCRLCreate,
+		ReadWithoutTimeout:   resourceCRLRead,
+		UpdateWithoutTimeout: resourceCRLUpdate,
+		DeleteWithoutTimeout: resourceCRLDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"crl_data": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"crl_data", "crl_s3_object", "crl_url"},
+			},
+			"crl_data_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"crl_s3_object": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrBucket: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"version_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"crl_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			names.AttrEnabled: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"trust_anchor_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceCRLCustomizeDiff,
+		),
+	}
+}
+
+func resourceCRLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	crlData, err := crlContent(ctx, d, meta)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RolesAnywhere CRL (%s): %s", name, err)
+	}
+
+	input := &rolesanywhere.ImportCrlInput{
+		CrlData:        crlData,
+		Enabled:        aws.Bool(d.Get(names.AttrEnabled).(bool)),
+		Name:           aws.String(name),
+		Tags:           getTagsIn(ctx),
+		TrustAnchorArn: aws.String(d.Get("trust_anchor_arn").(string)),
+	}
+
+	output, err := conn.ImportCrl(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RolesAnywhere CRL (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Crl.CrlId))
+	d.Set("crl_data_hash", crlDataHash(crlData))
+
+	return append(diags, resourceCRLRead(ctx, d, meta)...)
+}
+
+func resourceCRLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
+
+	crl, err := FindCRLByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RolesAnywhere CRL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RolesAnywhere CRL (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, crl.CrlArn)
+	d.Set(names.AttrEnabled, crl.Enabled)
+	d.Set(names.AttrName, crl.Name)
+	d.Set("trust_anchor_arn", crl.TrustAnchorArn)
+
+	return diags
+}
+
+func resourceCRLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
+
+	if d.HasChanges("crl_data_hash", names.AttrName) {
+		input := &rolesanywhere.UpdateCrlInput{
+			CrlId: aws.String(d.Id()),
+			Name:  aws.String(d.Get(names.AttrName).(string)),
+		}
+
+		if d.HasChange("crl_data_hash") {
+			crlData, err := crlContent(ctx, d, meta)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating RolesAnywhere CRL (%s): %s", d.Id(), err)
+			}
+
+			input.CrlData = crlData
+		}
+
+		_, err := conn.UpdateCrl(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RolesAnywhere CRL (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange(names.AttrEnabled) {
+		var err error
+
+		if d.Get(names.AttrEnabled).(bool) {
+			_, err = conn.EnableCrl(ctx, &rolesanywhere.EnableCrlInput{
+				CrlId: aws.String(d.Id()),
+			})
+		} else {
+			_, err = conn.DisableCrl(ctx, &rolesanywhere.DisableCrlInput{
+				CrlId: aws.String(d.Id()),
+			})
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RolesAnywhere CRL (%s) enabled: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceCRLRead(ctx, d, meta)...)
+}
+
+func resourceCRLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
+
+	log.Printf("[DEBUG] Deleting RolesAnywhere CRL (%s)", d.Id())
+	_, err := conn.DeleteCrl(ctx, &rolesanywhere.DeleteCrlInput{
+		CrlId: aws.String(d.Id()),
+	})
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RolesAnywhere CRL (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// resourceCRLCustomizeDiff fetches the CRL from its source during planning so that a changed CRL,
+// e.g. after a certificate has been revoked, is updated in place on the next apply.
+func resourceCRLCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// The source may not be known until apply, e.g. when it refers to another resource's attributes.
+	for _, key := range []string{"crl_data", "crl_s3_object.0.bucket", "crl_s3_object.0.key", "crl_s3_object.0.version_id", "crl_url"} {
+		if !diff.NewValueKnown(key) {
+			return diff.SetNewComputed("crl_data_hash")
+		}
+	}
+
+	crlData, err := crlContent(ctx, diff, meta)
+
+	if err != nil {
+		return err
+	}
+
+	if hash := crlDataHash(crlData); hash != diff.Get("crl_data_hash").(string) {
+		return diff.SetNew("crl_data_hash", hash)
+	}
+
+	return nil
+}
+
+// crlContent returns the CRL from the configured source.
+func crlContent(ctx context.Context, d interface{ Get(string) any }, meta interface{}) ([]byte, error) {
+	if v, ok := d.Get("crl_data").(string); ok && v != "" {
+		return []byte(v), nil
+	}
+
+	if v, ok := d.Get("crl_s3_object").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		bucket, key := tfMap[names.AttrBucket].(string), tfMap[names.AttrKey].(string)
+
+		input := &s3.GetObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+
+		if v, ok := tfMap["version_id"].(string); ok && v != "" {
+			input.VersionId = aws.String(v)
+		}
+
+		output, err := meta.(*conns.AWSClient).S3Client(ctx).GetObject(ctx, input)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading CRL from S3 object (s3://%s/%s): %w", bucket, key, err)
+		}
+
+		defer output.Body.Close()
+
+		return io.ReadAll(output.Body)
+	}
+
+	if v, ok := d.Get("crl_url").(string); ok && v != "" {
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, v, nil)
+
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := cleanhttp.DefaultClient().Do(request)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading CRL from URL (%s): %w", v, err)
+		}
+
+		defer response.Body.Close()
+
+		if response.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("reading CRL from URL (%s): unexpected HTTP status %s", v, response.Status)
+		}
+
+		return io.ReadAll(response.Body)
+	}
+
+	return nil, errors.New("no CRL source configured")
+}
+
+func crlDataHash(crlData []byte) string {
+	hash := sha256.Sum256(crlData)
+
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rolesanywhere_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrolesanywhere "github.com/hashicorp/terraform-provider-aws/internal/service/rolesanywhere"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRolesAnywhereCRL_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	caKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	caCertificate := acctest.TLSRSAX509SelfSignedCACertificateForRolesAnywhereTrustAnchorPEM(t, caKey)
+	crl1 := acctest.TLSRSAX509CRLPEM(t, caKey, caCertificate, 1)
+	crl2 := acctest.TLSRSAX509CRLPEM(t, caKey, caCertificate, 2)
+	resourceName := "aws_rolesanywhere_crl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RolesAnywhereServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCRLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCRLConfig_basic(rName, caCertificate, crl1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCRLExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "rolesanywhere", regexache.MustCompile(`crl/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "crl_data_hash"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "trust_anchor_arn", "aws_rolesanywhere_trust_anchor.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"crl_data", "crl_data_hash"},
+			},
+			{
+				Config: testAccCRLConfig_basic(rName, caCertificate, crl2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCRLExists(ctx, resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRolesAnywhereCRL_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	caKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	caCertificate := acctest.TLSRSAX509SelfSignedCACertificateForRolesAnywhereTrustAnchorPEM(t, caKey)
+	crl := acctest.TLSRSAX509CRLPEM(t, caKey, caCertificate, 1)
+	resourceName := "aws_rolesanywhere_crl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RolesAnywhereServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCRLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCRLConfig_basic(rName, caCertificate, crl),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCRLExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfrolesanywhere.ResourceCRL(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRolesAnywhereCRL_s3Object(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	caKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	caCertificate := acctest.TLSRSAX509SelfSignedCACertificateForRolesAnywhereTrustAnchorPEM(t, caKey)
+	crl1 := acctest.TLSRSAX509CRLPEM(t, caKey, caCertificate, 1)
+	crl2 := acctest.TLSRSAX509CRLPEM(t, caKey, caCertificate, 2)
+	resourceName := "aws_rolesanywhere_crl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RolesAnywhereServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCRLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCRLConfig_s3Object(rName, caCertificate, crl1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCRLExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "crl_s3_object.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "crl_data_hash"),
+				),
+			},
+			{
+				Config: testAccCRLConfig_s3Object(rName, caCertificate, crl2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCRLExists(ctx, resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCRLDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RolesAnywhereClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_rolesanywhere_crl" {
+				continue
+			}
+
+			_, err := tfrolesanywhere.FindCRLByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("RolesAnywhere CRL %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCRLExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RolesAnywhere CRL ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RolesAnywhereClient(ctx)
+
+		_, err := tfrolesanywhere.FindCRLByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCRLConfig_base(rName, caCertificate string) string {
+	return fmt.Sprintf(`
+resource "aws_rolesanywhere_trust_anchor" "test" {
+  name = %[1]q
+  source {
+    source_data {
+      x509_certificate_data = "%[2]s"
+    }
+    source_type = "CERTIFICATE_BUNDLE"
+  }
+}
+`, rName, acctest.TLSPEMEscapeNewlines(caCertificate))
+}
+
+func testAccCRLConfig_basic(rName, caCertificate, crl string) string {
+	return acctest.ConfigCompose(
+		testAccCRLConfig_base(rName, caCertificate),
+		fmt.Sprintf(`
+resource "aws_rolesanywhere_crl" "test" {
+  name             = %[1]q
+  crl_data         = "%[2]s"
+  trust_anchor_arn = aws_rolesanywhere_trust_anchor.test.arn
+  enabled          = true
+}
+`, rName, acctest.TLSPEMEscapeNewlines(crl)))
+}
+
+func testAccCRLConfig_s3Object(rName, caCertificate, crl string) string {
+	return acctest.ConfigCompose(
+		testAccCRLConfig_base(rName, caCertificate),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_object" "test" {
+  # Must have bucket versioning enabled first
+  depends_on = [aws_s3_bucket_versioning.test]
+
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "crl.pem"
+  content = "%[2]s"
+}
+
+resource "aws_rolesanywhere_crl" "test" {
+  name             = %[1]q
+  trust_anchor_arn = aws_rolesanywhere_trust_anchor.test.arn
+
+  crl_s3_object {
+    bucket     = aws_s3_object.test.bucket
+    key        = aws_s3_object.test.key
+    version_id = aws_s3_object.test.version_id
+  }
+}
+`, rName, acctest.TLSPEMEscapeNewlines(crl)))
+}
@@ -14,6 +14,32 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func FindCRLByID(ctx context.Context, conn *rolesanywhere.Client, id string) (*types.CrlDetail, error) {
+	in := &rolesanywhere.GetCrlInput{
+		CrlId: aws.String(id),
+	}
+
+	out, err := conn.GetCrl(ctx, in)
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Crl == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.Crl, nil
+}
+
 func FindProfileByID(ctx context.Context, conn *rolesanywhere.Client, id string) (*types.ProfileDetail, error) {
 	in := &rolesanywhere.GetProfileInput{
 		ProfileId: aws.String(id),
@@ -17,12 +17,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -253,6 +255,11 @@ func resourceBucketLifecycleConfiguration() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: customdiff.All(
+			resourceBucketLifecycleConfigurationCustomizeDiff,
+			resourceBucketLifecycleConfigurationRuleConflictsCustomizeDiff,
+		),
 	}
 }
 
@@ -262,6 +269,10 @@ func resourceBucketLifecycleConfigurationCreate(ctx context.Context, d *schema.R
 
 	bucket := d.Get(names.AttrBucket).(string)
 	expectedBucketOwner := d.Get(names.AttrExpectedBucketOwner).(string)
+	for _, v := range lifecycleRuleConflicts(d.Get(names.AttrRule).([]interface{})) {
+		diags = sdkdiag.AppendWarningf(diags, "S3 Bucket (%s) Lifecycle Configuration: %s", bucket, v)
+	}
+
 	rules := expandLifecycleRules(ctx, d.Get(names.AttrRule).([]interface{}))
 	input := &s3.PutBucketLifecycleConfigurationInput{
 		Bucket: aws.String(bucket),
@@ -277,10 +288,6 @@ func resourceBucketLifecycleConfigurationCreate(ctx context.Context, d *schema.R
 		return conn.PutBucketLifecycleConfiguration(ctx, input)
 	}, errCodeNoSuchBucket)
 
-	if tfawserr.ErrMessageContains(err, errCodeInvalidArgument, "LifecycleConfiguration is not valid, expected CreateBucketConfiguration") {
-		err = errDirectoryBucket(err)
-	}
-
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating S3 Bucket (%s) Lifecycle Configuration: %s", bucket, err)
 	}
@@ -366,6 +373,10 @@ func resourceBucketLifecycleConfigurationUpdate(ctx context.Context, d *schema.R
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	for _, v := range lifecycleRuleConflicts(d.Get(names.AttrRule).([]interface{})) {
+		diags = sdkdiag.AppendWarningf(diags, "S3 Bucket Lifecycle Configuration (%s): %s", d.Id(), v)
+	}
+
 	rules := expandLifecycleRules(ctx, d.Get(names.AttrRule).([]interface{}))
 	input := &s3.PutBucketLifecycleConfigurationInput{
 		Bucket: aws.String(bucket),
@@ -431,6 +442,219 @@ func resourceBucketLifecycleConfigurationDelete(ctx context.Context, d *schema.R
 	return diags
 }
 
+// resourceBucketLifecycleConfigurationCustomizeDiff rejects lifecycle rule elements that directory buckets don't support.
+// Directory buckets support only expiration by days and abort incomplete multipart upload actions,
+// filtered by prefix and object size.
+func resourceBucketLifecycleConfigurationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !isDirectoryBucket(diff.Get(names.AttrBucket).(string)) {
+		return nil
+	}
+
+	for i, v := range diff.Get(names.AttrRule).([]interface{}) {
+		tfMap, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, k := range []string{"noncurrent_version_expiration", "noncurrent_version_transition", "transition"} {
+			if v, ok := tfMap[k]; ok && !isEmptyLifecycleRuleElement(v) {
+				return fmt.Errorf("rule.%d.%s is not supported for directory buckets", i, k)
+			}
+		}
+
+		if v, ok := tfMap["expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			expiration := v[0].(map[string]interface{})
+
+			if v, ok := expiration["date"].(string); ok && v != "" {
+				return fmt.Errorf("rule.%d.expiration.date is not supported for directory buckets, use days", i)
+			}
+
+			if v, ok := expiration["expired_object_delete_marker"].(bool); ok && v {
+				return fmt.Errorf("rule.%d.expiration.expired_object_delete_marker is not supported for directory buckets", i)
+			}
+		}
+
+		if v, ok := tfMap[names.AttrFilter].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			filter := v[0].(map[string]interface{})
+
+			if v, ok := filter["tag"]; ok && !isEmptyLifecycleRuleElement(v) {
+				return fmt.Errorf("rule.%d.filter.tag is not supported for directory buckets", i)
+			}
+
+			if v, ok := filter["and"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				if v, ok := v[0].(map[string]interface{})[names.AttrTags].(map[string]interface{}); ok && len(v) > 0 {
+					return fmt.Errorf("rule.%d.filter.and.tags is not supported for directory buckets", i)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// resourceBucketLifecycleConfigurationRuleConflictsCustomizeDiff logs lifecycle rules that S3 accepts but that won't behave as configured.
+// The Plugin SDK can't return warning diagnostics from CustomizeDiff, so the same conflicts are also reported as warnings on apply.
+func resourceBucketLifecycleConfigurationRuleConflictsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if rawConfig := diff.GetRawConfig(); rawConfig.IsNull() || !rawConfig.GetAttr(names.AttrRule).IsWhollyKnown() {
+		return nil
+	}
+
+	for _, v := range lifecycleRuleConflicts(diff.Get(names.AttrRule).([]interface{})) {
+		log.Printf("[WARN] S3 Bucket (%s) Lifecycle Configuration: %s", diff.Get(names.AttrBucket).(string), v)
+	}
+
+	return nil
+}
+
+// lifecycleRuleConflicts returns descriptions of enabled lifecycle rules whose prefix filters overlap and whose
+// expiration or transition days conflict. Rules that also filter on tags or object size are not considered,
+// as whether they apply to the same objects can't be determined from the configuration.
+func lifecycleRuleConflicts(tfList []interface{}) []string {
+	type lifecycleRule struct {
+		id             string
+		prefix         string
+		expiration     int
+		storageClasses []string
+		transitions    map[string]int
+	}
+
+	var rules []lifecycleRule
+
+	for _, v := range tfList {
+		tfMap, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap[names.AttrStatus].(string); !ok || v != lifecycleRuleStatusEnabled {
+			continue
+		}
+
+		prefix, ok := lifecycleRulePrefix(tfMap)
+		if !ok {
+			continue
+		}
+
+		rule := lifecycleRule{
+			id:          tfMap[names.AttrID].(string),
+			prefix:      prefix,
+			transitions: make(map[string]int),
+		}
+
+		if v, ok := tfMap["expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if v, ok := v[0].(map[string]interface{})["days"].(int); ok {
+				rule.expiration = v
+			}
+		}
+
+		if v, ok := tfMap["transition"].(*schema.Set); ok {
+			for _, v := range v.List() {
+				transition := v.(map[string]interface{})
+
+				if v, ok := transition["date"].(string); ok && v != "" {
+					continue
+				}
+
+				rule.transitions[transition[names.AttrStorageClass].(string)] = transition["days"].(int)
+			}
+		}
+
+		rule.storageClasses = tfmaps.Keys(rule.transitions)
+		slices.Sort(rule.storageClasses)
+
+		rules = append(rules, rule)
+	}
+
+	var conflicts []string
+
+	for i, rule1 := range rules {
+		for _, rule2 := range rules[i+1:] {
+			if !strings.HasPrefix(rule1.prefix, rule2.prefix) && !strings.HasPrefix(rule2.prefix, rule1.prefix) {
+				continue
+			}
+
+			if rule1.expiration > 0 && rule2.expiration > 0 && rule1.expiration != rule2.expiration {
+				conflicts = append(conflicts, fmt.Sprintf("rules %q and %q have overlapping prefixes and expire objects after %d and %d days; S3 applies the shorter expiration", rule1.id, rule2.id, rule1.expiration, rule2.expiration))
+			}
+
+			for _, pair := range [][2]lifecycleRule{{rule1, rule2}, {rule2, rule1}} {
+				transitionRule, expirationRule := pair[0], pair[1]
+
+				if expirationRule.expiration == 0 {
+					continue
+				}
+
+				for _, storageClass := range transitionRule.storageClasses {
+					if days := transitionRule.transitions[storageClass]; days >= expirationRule.expiration {
+						conflicts = append(conflicts, fmt.Sprintf("rule %q transitions objects to %s after %d days, but rule %q has an overlapping prefix and expires them after %d days", transitionRule.id, storageClass, days, expirationRule.id, expirationRule.expiration))
+					}
+				}
+			}
+
+			for _, storageClass := range rule1.storageClasses {
+				if days1, days2 := rule1.transitions[storageClass], rule2.transitions[storageClass]; slices.Contains(rule2.storageClasses, storageClass) && days1 != days2 {
+					conflicts = append(conflicts, fmt.Sprintf("rules %q and %q have overlapping prefixes and transition objects to %s after %d and %d days", rule1.id, rule2.id, storageClass, days1, days2))
+				}
+			}
+		}
+	}
+
+	return conflicts
+}
+
+// lifecycleRulePrefix returns the object key prefix that a lifecycle rule applies to.
+// The second return value is false if the rule also filters on tags or object size.
+func lifecycleRulePrefix(tfMap map[string]interface{}) (string, bool) {
+	v, ok := tfMap[names.AttrFilter].([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		prefix, _ := tfMap[names.AttrPrefix].(string)
+		return prefix, true
+	}
+
+	filter := v[0].(map[string]interface{})
+
+	if v, ok := filter["tag"]; ok && !isEmptyLifecycleRuleElement(v) {
+		return "", false
+	}
+
+	for _, k := range []string{"object_size_greater_than", "object_size_less_than"} {
+		if v, ok := filter[k].(string); ok && v != "" {
+			return "", false
+		}
+	}
+
+	if v, ok := filter["and"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		and := v[0].(map[string]interface{})
+
+		if v, ok := and[names.AttrTags].(map[string]interface{}); ok && len(v) > 0 {
+			return "", false
+		}
+
+		for _, k := range []string{"object_size_greater_than", "object_size_less_than"} {
+			if v, ok := and[k].(int); ok && v > 0 {
+				return "", false
+			}
+		}
+
+		prefix, _ := and[names.AttrPrefix].(string)
+		return prefix, true
+	}
+
+	prefix, _ := filter[names.AttrPrefix].(string)
+	return prefix, true
+}
+
+func isEmptyLifecycleRuleElement(v interface{}) bool {
+	switch v := v.(type) {
+	case []interface{}:
+		return len(v) == 0 || v[0] == nil
+	case *schema.Set:
+		return v.Len() == 0
+	default:
+		return v == nil
+	}
+}
+
 // suppressMissingFilterConfigurationBlock suppresses the diff that results from an omitted
 // filter configuration block and one returned from the S3 API.
 // To work around the issue, https://github.com/hashicorp/terraform-plugin-sdk/issues/743,
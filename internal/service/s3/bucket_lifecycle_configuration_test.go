@@ -11,6 +11,8 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -21,6 +23,138 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestLifecycleRuleConflicts(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rules    []interface{}
+		expected []string
+	}{
+		"no overlap": {
+			rules: []interface{}{
+				map[string]interface{}{
+					names.AttrID:     "logs",
+					names.AttrStatus: "Enabled",
+					names.AttrFilter: []interface{}{map[string]interface{}{names.AttrPrefix: "logs/"}},
+					"expiration":     []interface{}{map[string]interface{}{"days": 30}},
+				},
+				map[string]interface{}{
+					names.AttrID:     "tmp",
+					names.AttrStatus: "Enabled",
+					names.AttrFilter: []interface{}{map[string]interface{}{names.AttrPrefix: "tmp/"}},
+					"expiration":     []interface{}{map[string]interface{}{"days": 1}},
+				},
+			},
+		},
+		"different expiration days": {
+			rules: []interface{}{
+				map[string]interface{}{
+					names.AttrID:     "logs",
+					names.AttrStatus: "Enabled",
+					names.AttrFilter: []interface{}{map[string]interface{}{names.AttrPrefix: "logs/"}},
+					"expiration":     []interface{}{map[string]interface{}{"days": 30}},
+				},
+				map[string]interface{}{
+					names.AttrID:     "app-logs",
+					names.AttrStatus: "Enabled",
+					names.AttrFilter: []interface{}{map[string]interface{}{names.AttrPrefix: "logs/app/"}},
+					"expiration":     []interface{}{map[string]interface{}{"days": 90}},
+				},
+			},
+			expected: []string{
+				`rules "logs" and "app-logs" have overlapping prefixes and expire objects after 30 and 90 days; S3 applies the shorter expiration`,
+			},
+		},
+		"transition after expiration": {
+			rules: []interface{}{
+				map[string]interface{}{
+					names.AttrID:     "all",
+					names.AttrStatus: "Enabled",
+					"expiration":     []interface{}{map[string]interface{}{"days": 30}},
+				},
+				map[string]interface{}{
+					names.AttrID:     "archive",
+					names.AttrStatus: "Enabled",
+					names.AttrFilter: []interface{}{map[string]interface{}{names.AttrPrefix: "archive/"}},
+					"transition":     []interface{}{map[string]interface{}{"days": 60, names.AttrStorageClass: "GLACIER"}},
+				},
+			},
+			expected: []string{
+				`rule "archive" transitions objects to GLACIER after 60 days, but rule "all" has an overlapping prefix and expires them after 30 days`,
+			},
+		},
+		"different transition days": {
+			rules: []interface{}{
+				map[string]interface{}{
+					names.AttrID:     "rule1",
+					names.AttrStatus: "Enabled",
+					names.AttrFilter: []interface{}{map[string]interface{}{
+						"and": []interface{}{map[string]interface{}{names.AttrPrefix: "data/"}},
+					}},
+					"transition": []interface{}{map[string]interface{}{"days": 30, names.AttrStorageClass: "STANDARD_IA"}},
+				},
+				map[string]interface{}{
+					names.AttrID:     "rule2",
+					names.AttrStatus: "Enabled",
+					names.AttrPrefix: "data/",
+					"transition":     []interface{}{map[string]interface{}{"days": 45, names.AttrStorageClass: "STANDARD_IA"}},
+				},
+			},
+			expected: []string{
+				`rules "rule1" and "rule2" have overlapping prefixes and transition objects to STANDARD_IA after 30 and 45 days`,
+			},
+		},
+		"disabled rule": {
+			rules: []interface{}{
+				map[string]interface{}{
+					names.AttrID:     "logs",
+					names.AttrStatus: "Enabled",
+					"expiration":     []interface{}{map[string]interface{}{"days": 30}},
+				},
+				map[string]interface{}{
+					names.AttrID:     "old-logs",
+					names.AttrStatus: "Disabled",
+					"expiration":     []interface{}{map[string]interface{}{"days": 90}},
+				},
+			},
+		},
+		"tag filter": {
+			rules: []interface{}{
+				map[string]interface{}{
+					names.AttrID:     "logs",
+					names.AttrStatus: "Enabled",
+					"expiration":     []interface{}{map[string]interface{}{"days": 30}},
+				},
+				map[string]interface{}{
+					names.AttrID:     "tagged",
+					names.AttrStatus: "Enabled",
+					names.AttrFilter: []interface{}{map[string]interface{}{
+						"tag": []interface{}{map[string]interface{}{names.AttrKey: "retain", names.AttrValue: "true"}},
+					}},
+					"expiration": []interface{}{map[string]interface{}{"days": 365}},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, tfs3.ResourceBucketLifecycleConfiguration().Schema, map[string]interface{}{
+				names.AttrBucket: "test",
+				names.AttrRule:   testCase.rules,
+			})
+
+			got := tfs3.LifecycleRuleConflicts(d.Get(names.AttrRule).([]interface{}))
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestAccS3BucketLifecycleConfiguration_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -57,6 +191,29 @@ func TestAccS3BucketLifecycleConfiguration_basic(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketLifecycleConfiguration_conflictingRules(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_lifecycle_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// Conflicting rules are reported as warnings and don't prevent the configuration from being applied.
+				Config: testAccBucketLifecycleConfigurationConfig_conflictingRules(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketLifecycleConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3BucketLifecycleConfiguration_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1052,6 +1209,7 @@ func TestAccS3BucketLifecycleConfiguration_Update_filterWithAndToFilterWithPrefi
 func TestAccS3BucketLifecycleConfiguration_directoryBucket(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_lifecycle_configuration.test"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
@@ -1060,8 +1218,38 @@ func TestAccS3BucketLifecycleConfiguration_directoryBucket(t *testing.T) {
 		CheckDestroy:             testAccCheckBucketLifecycleConfigurationDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config:      testAccBucketLifecycleConfigurationConfig_directoryBucket(rName),
-				ExpectError: regexache.MustCompile(`directory buckets are not supported`),
+				Config: testAccBucketLifecycleConfigurationConfig_directoryBucket(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketLifecycleConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrBucket, "aws_s3_directory_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.expiration.0.days", "365"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.filter.0.prefix", "logs/"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.abort_incomplete_multipart_upload.0.days_after_initiation", "7"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketLifecycleConfiguration_directoryBucketUnsupportedRule(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketLifecycleConfigurationConfig_directoryBucketTransition(rName),
+				ExpectError: regexache.MustCompile(`rule.0.transition is not supported for directory buckets`),
 			},
 		},
 	})
@@ -1138,6 +1326,44 @@ resource "aws_s3_bucket_lifecycle_configuration" "test" {
 `, rName)
 }
 
+func testAccBucketLifecycleConfigurationConfig_conflictingRules(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  rule {
+    id     = "%[1]s-1"
+    status = "Enabled"
+
+    filter {
+      prefix = "logs/"
+    }
+
+    expiration {
+      days = 30
+    }
+  }
+
+  rule {
+    id     = "%[1]s-2"
+    status = "Enabled"
+
+    filter {
+      prefix = "logs/archive/"
+    }
+
+    expiration {
+      days = 90
+    }
+  }
+}
+`, rName)
+}
+
 func testAccBucketLifecycleConfigurationConfig_multipleRulesNoFilterOrPrefix(rName, status string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -1786,14 +2012,56 @@ resource "aws_s3_directory_bucket" "test" {
 
 resource "aws_s3_bucket_lifecycle_configuration" "test" {
   bucket = aws_s3_directory_bucket.test.bucket
+
   rule {
-    id     = %[1]q
+    id     = "%[1]s-expiration"
     status = "Enabled"
 
+    filter {
+      prefix = "logs/"
+    }
+
     expiration {
       days = 365
     }
   }
+
+  rule {
+    id     = "%[1]s-abort"
+    status = "Enabled"
+
+    filter {}
+
+    abort_incomplete_multipart_upload {
+      days_after_initiation = 7
+    }
+  }
+}
+`, rName))
+}
+
+func testAccBucketLifecycleConfigurationConfig_directoryBucketTransition(rName string) string {
+	return acctest.ConfigCompose(testAccDirectoryBucketConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_bucket" "test" {
+  bucket = local.bucket
+
+  location {
+    name = local.location_name
+  }
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_directory_bucket.test.bucket
+
+  rule {
+    id     = %[1]q
+    status = "Enabled"
+
+    transition {
+      days          = 30
+      storage_class = "STANDARD_IA"
+    }
+  }
 }
 `, rName))
 }
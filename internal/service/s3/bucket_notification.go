@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -33,7 +35,10 @@ func resourceBucketNotification() *schema.Resource {
 		DeleteWithoutTimeout: resourceBucketNotificationDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("exclusive", true)
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -47,6 +52,11 @@ func resourceBucketNotification() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"lambda_function": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -286,6 +296,35 @@ func resourceBucketNotificationPut(ctx context.Context, d *schema.ResourceData,
 		topicConfigs = append(topicConfigs, tc)
 	}
 
+	// In non-exclusive mode, merge with the notification configurations that this resource doesn't manage.
+	if !d.Get("exclusive").(bool) {
+		output, err := findBucketNotificationConfiguration(ctx, conn, bucket, "")
+
+		switch {
+		case tfresource.NotFound(err):
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Notification: %s", bucket, err)
+		default:
+			ids := bucketNotificationConfigurationIDs(d, true)
+			for _, v := range lambdaConfigs {
+				ids = append(ids, aws.ToString(v.Id))
+			}
+			for _, v := range queueConfigs {
+				ids = append(ids, aws.ToString(v.Id))
+			}
+			for _, v := range topicConfigs {
+				ids = append(ids, aws.ToString(v.Id))
+			}
+
+			if o, _ := d.GetChange("eventbridge"); eventbridgeConfig == nil && !o.(bool) {
+				eventbridgeConfig = output.EventBridgeConfiguration
+			}
+			lambdaConfigs = append(lambdaConfigs, filterLambdaFunctionConfigurations(output.LambdaFunctionConfigurations, ids, false)...)
+			queueConfigs = append(queueConfigs, filterQueueConfigurations(output.QueueConfigurations, ids, false)...)
+			topicConfigs = append(topicConfigs, filterTopicConfigurations(output.TopicConfigurations, ids, false)...)
+		}
+	}
+
 	notificationConfiguration := &types.NotificationConfiguration{}
 	if eventbridgeConfig != nil {
 		notificationConfiguration.EventBridgeConfiguration = eventbridgeConfig
@@ -316,6 +355,13 @@ func resourceBucketNotificationPut(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "creating S3 Bucket (%s) Notification: %s", bucket, err)
 	}
 
+	if !d.Get("exclusive").(bool) {
+		// Record any generated IDs so that Read can identify the managed notification configurations.
+		d.Set("lambda_function", flattenLambdaFunctionConfigurations(lambdaConfigs[:len(lambdaFunctionNotifications)]))
+		d.Set("queue", flattenQueueConfigurations(queueConfigs[:len(queueNotifications)]))
+		d.Set("topic", flattenTopicConfigurations(topicConfigs[:len(topicNotifications)]))
+	}
+
 	if d.IsNewResource() {
 		d.SetId(bucket)
 
@@ -347,15 +393,37 @@ func resourceBucketNotificationRead(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket Notification (%s): %s", d.Id(), err)
 	}
 
+	// State written before exclusive was added doesn't include it, and the previous behavior was exclusive.
+	if _, ok := d.GetOkExists("exclusive"); !ok {
+		d.Set("exclusive", true)
+	}
+
+	eventbridgeConfig := output.EventBridgeConfiguration
+	lambdaConfigs := output.LambdaFunctionConfigurations
+	queueConfigs := output.QueueConfigurations
+	topicConfigs := output.TopicConfigurations
+
+	// In non-exclusive mode, ignore the notification configurations that this resource doesn't manage.
+	if !d.Get("exclusive").(bool) {
+		ids := bucketNotificationConfigurationIDs(d, false)
+
+		if !d.Get("eventbridge").(bool) {
+			eventbridgeConfig = nil
+		}
+		lambdaConfigs = filterLambdaFunctionConfigurations(lambdaConfigs, ids, true)
+		queueConfigs = filterQueueConfigurations(queueConfigs, ids, true)
+		topicConfigs = filterTopicConfigurations(topicConfigs, ids, true)
+	}
+
 	d.Set(names.AttrBucket, d.Id())
-	d.Set("eventbridge", output.EventBridgeConfiguration != nil)
-	if err := d.Set("lambda_function", flattenLambdaFunctionConfigurations(output.LambdaFunctionConfigurations)); err != nil {
+	d.Set("eventbridge", eventbridgeConfig != nil)
+	if err := d.Set("lambda_function", flattenLambdaFunctionConfigurations(lambdaConfigs)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting lambda_function: %s", err)
 	}
-	if err := d.Set("queue", flattenQueueConfigurations(output.QueueConfigurations)); err != nil {
+	if err := d.Set("queue", flattenQueueConfigurations(queueConfigs)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting queue: %s", err)
 	}
-	if err := d.Set("topic", flattenTopicConfigurations(output.TopicConfigurations)); err != nil {
+	if err := d.Set("topic", flattenTopicConfigurations(topicConfigs)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting queue: %s", err)
 	}
 
@@ -371,6 +439,30 @@ func resourceBucketNotificationDelete(ctx context.Context, d *schema.ResourceDat
 		NotificationConfiguration: &types.NotificationConfiguration{},
 	}
 
+	// In non-exclusive mode, retain the notification configurations that this resource doesn't manage.
+	if !d.Get("exclusive").(bool) {
+		output, err := findBucketNotificationConfiguration(ctx, conn, d.Id(), "")
+
+		if tfresource.NotFound(err) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 Bucket Notification (%s): %s", d.Id(), err)
+		}
+
+		ids := bucketNotificationConfigurationIDs(d, false)
+		notificationConfiguration := &types.NotificationConfiguration{
+			LambdaFunctionConfigurations: filterLambdaFunctionConfigurations(output.LambdaFunctionConfigurations, ids, false),
+			QueueConfigurations:          filterQueueConfigurations(output.QueueConfigurations, ids, false),
+			TopicConfigurations:          filterTopicConfigurations(output.TopicConfigurations, ids, false),
+		}
+		if !d.Get("eventbridge").(bool) {
+			notificationConfiguration.EventBridgeConfiguration = output.EventBridgeConfiguration
+		}
+		input.NotificationConfiguration = notificationConfiguration
+	}
+
 	log.Printf("[DEBUG] Deleting S3 Bucket Notification: %s", d.Id())
 	_, err := conn.PutBucketNotificationConfiguration(ctx, input)
 
@@ -415,6 +507,51 @@ func findBucketNotificationConfiguration(ctx context.Context, conn *s3.Client, b
 	return output, nil
 }
 
+// bucketNotificationConfigurationIDs returns the IDs of the notification configurations in the
+// lambda_function, queue and topic configuration blocks, optionally including any prior values.
+func bucketNotificationConfigurationIDs(d *schema.ResourceData, includeOld bool) []string {
+	var ids []string
+
+	for _, k := range []string{"lambda_function", "queue", "topic"} {
+		o, n := d.GetChange(k)
+		tfList := n.([]interface{})
+		if includeOld {
+			tfList = append(tfList, o.([]interface{})...)
+		}
+
+		for _, v := range tfList {
+			tfMap, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if v, ok := tfMap[names.AttrID].(string); ok && v != "" {
+				ids = append(ids, v)
+			}
+		}
+	}
+
+	return ids
+}
+
+func filterLambdaFunctionConfigurations(configs []types.LambdaFunctionConfiguration, ids []string, include bool) []types.LambdaFunctionConfiguration {
+	return tfslices.Filter(configs, func(v types.LambdaFunctionConfiguration) bool {
+		return slices.Contains(ids, aws.ToString(v.Id)) == include
+	})
+}
+
+func filterQueueConfigurations(configs []types.QueueConfiguration, ids []string, include bool) []types.QueueConfiguration {
+	return tfslices.Filter(configs, func(v types.QueueConfiguration) bool {
+		return slices.Contains(ids, aws.ToString(v.Id)) == include
+	})
+}
+
+func filterTopicConfigurations(configs []types.TopicConfiguration, ids []string, include bool) []types.TopicConfiguration {
+	return tfslices.Filter(configs, func(v types.TopicConfiguration) bool {
+		return slices.Contains(ids, aws.ToString(v.Id)) == include
+	})
+}
+
 func flattenNotificationConfigurationFilter(filter *types.NotificationConfigurationFilter) map[string]interface{} {
 	filterRules := map[string]interface{}{}
 	if filter.Key == nil || filter.Key.FilterRules == nil {
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -252,6 +253,58 @@ func TestAccS3BucketNotification_update(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketNotification_nonExclusive(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v s3.GetBucketNotificationConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resource1Name := "aws_s3_bucket_notification.test1"
+	resource2Name := "aws_s3_bucket_notification.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationConfig_nonExclusive(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resource1Name, "exclusive", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resource1Name, "queue.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resource1Name, "queue.0.id", "notification-sqs-1"),
+					resource.TestCheckResourceAttr(resource2Name, "exclusive", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resource2Name, "queue.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resource2Name, "queue.0.id"),
+					testAccCheckBucketNotificationExists(ctx, resource2Name, &v),
+					testAccCheckBucketNotificationQueueConfigurationCount(&v, 2),
+				),
+			},
+			{
+				Config: testAccBucketNotificationConfig_nonExclusiveSingle(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resource1Name, "queue.#", acctest.Ct1),
+					testAccCheckBucketNotificationExists(ctx, resource1Name, &v),
+					testAccCheckBucketNotificationQueueConfigurationCount(&v, 1),
+				),
+			},
+			{
+				Config: testAccBucketNotificationConfig_exclusiveSingle(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resource1Name, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resource1Name, "exclusive", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resource1Name, "queue.#", acctest.Ct1),
+					testAccCheckBucketNotificationExists(ctx, resource1Name, &v),
+					testAccCheckBucketNotificationQueueConfigurationCount(&v, 1),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3BucketNotification_directoryBucket(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -317,6 +370,16 @@ func testAccCheckBucketNotificationExists(ctx context.Context, n string, v *s3.G
 	}
 }
 
+func testAccCheckBucketNotificationQueueConfigurationCount(v *s3.GetBucketNotificationConfigurationOutput, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := len(v.QueueConfigurations); got != expected {
+			return fmt.Errorf("S3 Bucket Notification queue configurations: expected %d, got %d", expected, got)
+		}
+
+		return nil
+	}
+}
+
 func testAccBucketNotificationConfig_eventBridge(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -768,3 +831,74 @@ resource "aws_s3_bucket_notification" "test" {
 }
 `)
 }
+
+func testAccBucketNotificationConfig_exclusiveBase(rName string, exclusive bool) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": "sqs:SendMessage",
+      "Resource": "arn:${data.aws_partition.current.partition}:sqs:*:*:%[1]s",
+      "Condition": {
+        "ArnEquals": {
+          "aws:SourceArn": "${aws_s3_bucket.test.arn}"
+        }
+      }
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_notification" "test1" {
+  bucket    = aws_s3_bucket.test.id
+  exclusive = %[2]t
+
+  queue {
+    id            = "notification-sqs-1"
+    queue_arn     = aws_sqs_queue.test.arn
+    events        = ["s3:ObjectCreated:*"]
+    filter_prefix = "first/"
+  }
+}
+`, rName, exclusive)
+}
+
+func testAccBucketNotificationConfig_nonExclusive(rName string) string {
+	return acctest.ConfigCompose(testAccBucketNotificationConfig_exclusiveBase(rName, false), `
+resource "aws_s3_bucket_notification" "test2" {
+  # Notification configuration updates must not be concurrent.
+  depends_on = [aws_s3_bucket_notification.test1]
+
+  bucket    = aws_s3_bucket.test.id
+  exclusive = false
+
+  queue {
+    queue_arn     = aws_sqs_queue.test.arn
+    events        = ["s3:ObjectCreated:*"]
+    filter_prefix = "second/"
+  }
+}
+`)
+}
+
+func testAccBucketNotificationConfig_nonExclusiveSingle(rName string) string {
+	return testAccBucketNotificationConfig_exclusiveBase(rName, false)
+}
+
+func testAccBucketNotificationConfig_exclusiveSingle(rName string) string {
+	return testAccBucketNotificationConfig_exclusiveBase(rName, true)
+}
@@ -5,9 +5,12 @@ package s3
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
@@ -96,7 +99,7 @@ func resourceBucketReplicationConfiguration() *schema.Resource {
 									names.AttrBucket: {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNCheck(replicationDestinationBucketARNCheck),
 									},
 									names.AttrEncryptionConfiguration: {
 										Type:     schema.TypeList,
@@ -309,6 +312,8 @@ func resourceBucketReplicationConfiguration() *schema.Resource {
 				Sensitive: true,
 			},
 		},
+
+		CustomizeDiff: resourceBucketReplicationConfigurationCustomizeDiff,
 	}
 }
 
@@ -446,6 +451,59 @@ func resourceBucketReplicationConfigurationDelete(ctx context.Context, d *schema
 	return diags
 }
 
+func resourceBucketReplicationConfigurationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for i, v := range diff.Get(names.AttrRule).([]interface{}) {
+		tfMap, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		tfList, ok := tfMap[names.AttrDestination].([]interface{})
+		if !ok || len(tfList) == 0 || tfList[0] == nil {
+			continue
+		}
+
+		destination := tfList[0].(map[string]interface{})
+
+		// The destination bucket ARN may not be known until apply.
+		parsedARN, err := arn.Parse(destination[names.AttrBucket].(string))
+		if err != nil || !isDirectoryBucketARN(parsedARN) {
+			continue
+		}
+
+		if v := destination[names.AttrStorageClass].(string); v != "" && v != string(types.StorageClassExpressOnezone) {
+			return fmt.Errorf("rule.%d.destination.storage_class: %q is not supported for directory bucket destinations, use %q or omit the argument", i, v, types.StorageClassExpressOnezone)
+		}
+	}
+
+	return nil
+}
+
+// replicationDestinationBucketARNCheck verifies that a replication destination is a general purpose bucket or a directory bucket.
+func replicationDestinationBucketARNCheck(v any, k string, arn arn.ARN) (ws []string, errors []error) {
+	switch arn.Service {
+	case "s3":
+	case "s3express":
+		if !isDirectoryBucketARN(arn) {
+			errors = append(errors, fmt.Errorf("%q (%s) is not a valid S3 directory bucket ARN, expected arn:PARTITION:s3express:REGION:ACCOUNT:bucket/BUCKET--AZ-ID--x-s3", k, v))
+		}
+	default:
+		errors = append(errors, fmt.Errorf("%q (%s) is not a valid S3 bucket or S3 directory bucket ARN", k, v))
+	}
+
+	return ws, errors
+}
+
+func isDirectoryBucketARN(arn arn.ARN) bool {
+	if arn.Service != "s3express" {
+		return false
+	}
+
+	bucket, ok := strings.CutPrefix(arn.Resource, "bucket/")
+
+	return ok && directoryBucketNameRegex.MatchString(bucket)
+}
+
 func findReplicationConfiguration(ctx context.Context, conn *s3.Client, bucket string) (*types.ReplicationConfiguration, error) {
 	input := &s3.GetBucketReplicationInput{
 		Bucket: aws.String(bucket),
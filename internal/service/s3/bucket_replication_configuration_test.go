@@ -1201,6 +1201,33 @@ func TestAccS3BucketReplicationConfiguration_directoryBucket(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketReplicationConfiguration_directoryBucketDestination(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesPlusProvidersAlternate(ctx, t, &providers),
+		CheckDestroy:             acctest.CheckWithProviders(testAccCheckBucketReplicationConfigurationDestroyWithProvider(ctx), &providers),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketReplicationConfigurationConfig_directoryBucketDestination(rName, "${local.bucket}", string(types.StorageClassStandard)),
+				ExpectError: regexache.MustCompile(`is not supported for directory bucket destinations`),
+			},
+			{
+				Config:      testAccBucketReplicationConfigurationConfig_directoryBucketDestination(rName, rName, string(types.StorageClassExpressOnezone)),
+				ExpectError: regexache.MustCompile(`is not a valid S3 directory bucket ARN`),
+			},
+		},
+	})
+}
+
 // testAccCheckBucketReplicationConfigurationDestroy is the equivalent of the "WithProvider"
 // version, but for use with "same region" tests requiring only one provider.
 func testAccCheckBucketReplicationConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
@@ -2442,3 +2469,29 @@ resource "aws_s3_bucket_replication_configuration" "test" {
   }
 }`, storageClass))
 }
+
+func testAccBucketReplicationConfigurationConfig_directoryBucketDestination(rName, destinationBucket, storageClass string) string {
+	return acctest.ConfigCompose(testAccBucketReplicationConfigurationConfig_base(rName), testAccDirectoryBucketConfig_base(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_s3_bucket_replication_configuration" "test" {
+  depends_on = [aws_s3_bucket_versioning.source]
+
+  bucket = aws_s3_bucket.source.id
+  role   = aws_iam_role.test.arn
+
+  rule {
+    id     = "foobar"
+    prefix = "foo"
+    status = "Enabled"
+
+    destination {
+      bucket        = "arn:${data.aws_partition.current.partition}:s3express:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:bucket/%[1]s"
+      storage_class = %[2]q
+    }
+  }
+}
+`, destinationBucket, storageClass))
+}
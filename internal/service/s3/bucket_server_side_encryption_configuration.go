@@ -5,7 +5,9 @@ package s3
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -42,6 +44,10 @@ func resourceBucketServerSideEncryptionConfiguration() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 63),
 			},
+			"bucket_key_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			names.AttrExpectedBucketOwner: {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -79,6 +85,8 @@ func resourceBucketServerSideEncryptionConfiguration() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: resourceBucketServerSideEncryptionConfigurationCustomizeDiff,
 	}
 }
 
@@ -145,6 +153,9 @@ func resourceBucketServerSideEncryptionConfigurationRead(ctx context.Context, d
 	}
 
 	d.Set(names.AttrBucket, bucket)
+	d.Set("bucket_key_enabled", slices.ContainsFunc(sse.Rules, func(v types.ServerSideEncryptionRule) bool {
+		return aws.ToBool(v.BucketKeyEnabled)
+	}))
 	d.Set(names.AttrExpectedBucketOwner, expectedBucketOwner)
 	if err := d.Set(names.AttrRule, flattenServerSideEncryptionRules(sse.Rules)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting rule: %s", err)
@@ -214,6 +225,39 @@ func resourceBucketServerSideEncryptionConfigurationDelete(ctx context.Context,
 	return diags
 }
 
+// resourceBucketServerSideEncryptionConfigurationCustomizeDiff validates the combination of encryption type,
+// KMS key and S3 Bucket Key in each rule.
+func resourceBucketServerSideEncryptionConfigurationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, v := range diff.Get(names.AttrRule).(*schema.Set).List() {
+		tfMap, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		v, ok := tfMap["apply_server_side_encryption_by_default"].([]interface{})
+		if !ok || len(v) == 0 || v[0] == nil {
+			continue
+		}
+
+		apply := v[0].(map[string]interface{})
+		sseAlgorithm := types.ServerSideEncryption(apply["sse_algorithm"].(string))
+
+		if sseAlgorithm == "" {
+			continue
+		}
+
+		if v, ok := apply["kms_master_key_id"].(string); ok && v != "" && sseAlgorithm != types.ServerSideEncryptionAwsKms && sseAlgorithm != types.ServerSideEncryptionAwsKmsDsse {
+			return fmt.Errorf("kms_master_key_id can only be specified when sse_algorithm is %q or %q", types.ServerSideEncryptionAwsKms, types.ServerSideEncryptionAwsKmsDsse)
+		}
+
+		if v, ok := tfMap["bucket_key_enabled"].(bool); ok && v && sseAlgorithm == types.ServerSideEncryptionAwsKmsDsse {
+			return fmt.Errorf("bucket_key_enabled is not supported when sse_algorithm is %q", types.ServerSideEncryptionAwsKmsDsse)
+		}
+	}
+
+	return nil
+}
+
 func findServerSideEncryptionConfiguration(ctx context.Context, conn *s3.Client, bucketName, expectedBucketOwner string) (*types.ServerSideEncryptionConfiguration, error) {
 	input := &s3.GetBucketEncryptionInput{
 		Bucket: aws.String(bucketName),
@@ -6,12 +6,16 @@ package s3
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -142,12 +146,13 @@ func resourceObject() *schema.Resource {
 			},
 			"etag": {
 				Type: schema.TypeString,
-				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload
-				// if/when it's actually implemented. The Etag then won't match raw-file MD5.
+				// This will conflict with SSE-C and SSE-KMS encryption.
+				// The Etag then won't match raw-file MD5.
 				// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTCommonResponseHeaders.html
-				Optional:      true,
-				Computed:      true,
-				ConflictsWith: []string{names.AttrKMSKeyID},
+				Optional:         true,
+				Computed:         true,
+				ConflictsWith:    []string{names.AttrKMSKeyID},
+				DiffSuppressFunc: suppressObjectMultipartETagDiff,
 			},
 			names.AttrForceDestroy: {
 				Type:     schema.TypeBool,
@@ -179,6 +184,16 @@ func resourceObject() *schema.Resource {
 				Elem:         &schema.Schema{Type: schema.TypeString},
 				ValidateFunc: validateMetadataIsLowerCase,
 			},
+			"multipart_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 64),
+			},
+			"multipart_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(5, 5120),
+			},
 			"object_lock_legal_hold_status": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -588,7 +603,15 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.ChecksumAlgorithm = types.ChecksumAlgorithmCrc32
 	}
 
-	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
+	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...), func(u *manager.Uploader) {
+		if v, ok := d.GetOk("multipart_concurrency"); ok {
+			u.Concurrency = v.(int)
+		}
+
+		if v, ok := d.GetOk("multipart_part_size"); ok {
+			u.PartSize = int64(v.(int)) * mebibyte
+		}
+	})
 
 	if _, err := uploader.Upload(ctx, input); err != nil {
 		return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
@@ -672,6 +695,132 @@ func hasObjectContentChanges(d sdkv2.ResourceDiffer) bool {
 	return false
 }
 
+const (
+	mebibyte = 1024 * 1024
+)
+
+var (
+	md5DigestRegex     = regexache.MustCompile(`^[0-9a-f]{32}$`)
+	multipartETagRegex = regexache.MustCompile(`^[0-9a-f]{32}-\d+$`)
+)
+
+// suppressObjectMultipartETagDiff suppresses the difference between a configured MD5 digest of the source file
+// and the ETag of an object uploaded in multiple parts, which is not an MD5 digest of the object data.
+// The difference is suppressed only if the source file still matches the uploaded object.
+func suppressObjectMultipartETagDiff(k, old, new string, d *schema.ResourceData) bool {
+	if !multipartETagRegex.MatchString(old) || !md5DigestRegex.MatchString(new) {
+		return false
+	}
+
+	source := d.Get(names.AttrSource).(string)
+	if source == "" {
+		return false
+	}
+
+	path, err := homedir.Expand(source)
+	if err != nil {
+		return false
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+
+	// The ETag's suffix is the number of parts, so a file of a different size often can't match and needn't be read.
+	partSize := objectMultipartPartSize(info.Size(), int64(d.Get("multipart_part_size").(int))*mebibyte)
+	if parts := (info.Size() + partSize - 1) / partSize; !strings.HasSuffix(old, "-"+strconv.FormatInt(parts, 10)) {
+		return false
+	}
+
+	key := objectMultipartETagCacheKey{
+		modTime:  info.ModTime(),
+		partSize: partSize,
+		path:     path,
+		size:     info.Size(),
+	}
+
+	if v, ok := objectMultipartETagCache.Load(key); ok {
+		return v.(string) == old
+	}
+
+	etag, err := objectMultipartETag(path, partSize)
+	if err != nil {
+		return false
+	}
+
+	objectMultipartETagCache.Store(key, etag)
+
+	return etag == old
+}
+
+type objectMultipartETagCacheKey struct {
+	modTime  time.Time
+	partSize int64
+	path     string
+	size     int64
+}
+
+// objectMultipartETagCache holds the multipart ETags of source files already hashed by this provider process,
+// as the diff suppression function is called several times for each resource during a plan.
+var objectMultipartETagCache sync.Map
+
+// objectMultipartPartSize returns the part size that the upload manager uses for an object of the specified size
+// and configured part size (0 for the default part size).
+func objectMultipartPartSize(size, partSize int64) int64 {
+	if partSize <= 0 {
+		partSize = manager.DefaultUploadPartSize
+	}
+	// The upload manager increases the part size if the object would otherwise have too many parts.
+	if size/partSize >= int64(manager.MaxUploadParts) {
+		partSize = size/int64(manager.MaxUploadParts) + 1
+	}
+
+	return partSize
+}
+
+// objectMultipartETag returns the ETag that Amazon S3 assigns to the specified file when it is uploaded
+// by the upload manager with the specified part size (0 for the default part size).
+func objectMultipartETag(path string, partSize int64) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	partSize = objectMultipartPartSize(info.Size(), partSize)
+
+	var digests []byte
+	var parts int
+
+	for {
+		hash := md5.New()
+		n, err := io.CopyN(hash, file, partSize)
+
+		if n > 0 {
+			digests = append(digests, hash.Sum(nil)...)
+			parts++
+		}
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return "", err
+		}
+	}
+
+	digest := md5.Sum(digests)
+
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(digest[:]), parts), nil
+}
+
 func findObjectByBucketAndKey(ctx context.Context, conn *s3.Client, bucket, key, etag, checksumAlgorithm string, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
 	input := &s3.HeadObjectInput{
 		Bucket: aws.String(bucket),
@@ -11,6 +11,7 @@ import (
 	"io"
 	"os"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -107,6 +108,56 @@ func TestSDKv1CompatibleCleanKey(t *testing.T) {
 	}
 }
 
+func TestObjectMultipartETag(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		data     string
+		partSize int64
+		want     string
+	}{
+		{
+			name:     "single part",
+			data:     "abc",
+			partSize: 4,
+			want:     "af5da9f45af7a300e3aded972f8ff687-1",
+		},
+		{
+			name:     "exact parts",
+			data:     "abcdefgh",
+			partSize: 4,
+			want:     "cb93ad6c9c920e2602b79a11ded63ddb-2",
+		},
+		{
+			name:     "partial last part",
+			data:     "abcdefghij",
+			partSize: 4,
+			want:     "446feba4c1b5cc7ad93bf4d44a0e36ac-3",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := testAccObjectCreateTempFile(t, testCase.data)
+			defer os.Remove(path)
+
+			got, err := tfs3.ObjectMultipartETag(path, testCase.partSize)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if want := testCase.want; got != want {
+				t.Errorf("ObjectMultipartETag(%q, %d) = %v, want %v", testCase.data, testCase.partSize, got, want)
+			}
+		})
+	}
+}
+
 func TestAccS3Object_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -281,6 +332,46 @@ func TestAccS3Object_source(t *testing.T) {
 	})
 }
 
+func TestAccS3Object_multipartUpload(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	// 11 MiB, uploaded in 3 parts of 5 MiB.
+	source := testAccObjectCreateTempFile(t, strings.Repeat("a", 11*1024*1024))
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_multipartUpload(rName, source, 5, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexache.MustCompile(`^[0-9a-f]{32}-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "multipart_concurrency", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "multipart_part_size", "5"),
+				),
+			},
+			{
+				Config:   testAccObjectConfig_multipartUpload(rName, source, 5, 2),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, names.AttrSource, "multipart_concurrency", "multipart_part_size"},
+				ImportStateIdFunc:       testAccObjectImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
 func TestAccS3Object_content(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -2235,6 +2326,23 @@ resource "aws_s3_object" "object" {
 `, rName, source)
 }
 
+func testAccObjectConfig_multipartUpload(rName, source string, partSize, concurrency int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket                = aws_s3_bucket.test.bucket
+  key                   = "test-key"
+  source                = %[2]q
+  etag                  = filemd5(%[2]q)
+  multipart_part_size   = %[3]d
+  multipart_concurrency = %[4]d
+}
+`, rName, source, partSize, concurrency)
+}
+
 func testAccObjectConfig_contentCharacteristics(rName string, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_s3_objects_checksum", name="Objects Checksum")
+func dataSourceObjectsChecksum() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceObjectsChecksumRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrBucket: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrExpectedBucketOwner: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"keys": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"checksum_crc32": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"checksum_crc32c": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"checksum_sha1": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"checksum_sha256": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"object_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"version_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"request_payer": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.RequestPayer](),
+			},
+		},
+	}
+}
+
+func dataSourceObjectsChecksumRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+	var optFns []func(*s3.Options)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+	// Via S3 access point: "Invalid configuration: region from ARN `us-east-1` does not match client region `aws-global` and UseArnRegion is `false`".
+	if arn.IsARN(bucket) && conn.Options().Region == names.GlobalRegionID {
+		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
+	}
+
+	var objects []interface{}
+
+	for _, v := range d.Get("keys").([]interface{}) {
+		key := sdkv1CompatibleCleanKey(v.(string))
+		input := &s3.GetObjectAttributesInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+			ObjectAttributes: []types.ObjectAttributes{
+				types.ObjectAttributesChecksum,
+				types.ObjectAttributesEtag,
+				types.ObjectAttributesObjectSize,
+			},
+		}
+		if v, ok := d.GetOk(names.AttrExpectedBucketOwner); ok {
+			input.ExpectedBucketOwner = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("request_payer"); ok {
+			input.RequestPayer = types.RequestPayer(v.(string))
+		}
+
+		output, err := findObjectAttributes(ctx, conn, input, optFns...)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Object (%s) attributes: %s", bucket, key, err)
+		}
+
+		if aws.ToBool(output.DeleteMarker) {
+			return sdkdiag.AppendErrorf(diags, "S3 Bucket (%s) Object (%s) has been deleted", bucket, key)
+		}
+
+		tfMap := map[string]interface{}{
+			// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
+			"etag":        strings.Trim(aws.ToString(output.ETag), `"`),
+			names.AttrKey: v.(string),
+			"object_size": aws.ToInt64(output.ObjectSize),
+			"version_id":  aws.ToString(output.VersionId),
+		}
+
+		if v := output.Checksum; v != nil {
+			tfMap["checksum_crc32"] = aws.ToString(v.ChecksumCRC32)
+			tfMap["checksum_crc32c"] = aws.ToString(v.ChecksumCRC32C)
+			tfMap["checksum_sha1"] = aws.ToString(v.ChecksumSHA1)
+			tfMap["checksum_sha256"] = aws.ToString(v.ChecksumSHA256)
+		}
+
+		objects = append(objects, tfMap)
+	}
+
+	d.SetId(bucket)
+	if err := d.Set("objects", objects); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting objects: %s", err)
+	}
+
+	return diags
+}
+
+func findObjectAttributes(ctx context.Context, conn *s3.Client, input *s3.GetObjectAttributesInput, optFns ...func(*s3.Options)) (*s3.GetObjectAttributesOutput, error) {
+	output, err := conn.GetObjectAttributes(ctx, input, optFns...)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchKey) || tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3ObjectsChecksumDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_objects_checksum.test"
+	object1ResourceName := "aws_s3_object.test1"
+	object2ResourceName := "aws_s3_object.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:                acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories:  acctest.ProtoV5ProviderFactories,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsChecksumDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "objects.#", acctest.Ct2),
+					resource.TestCheckResourceAttrPair(dataSourceName, "objects.0.checksum_sha256", object1ResourceName, "checksum_sha256"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.checksum_crc32", ""),
+					resource.TestCheckResourceAttrPair(dataSourceName, "objects.0.etag", object1ResourceName, "etag"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "objects.0.key", object1ResourceName, names.AttrKey),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.object_size", "11"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "objects.1.checksum_crc32", object2ResourceName, "checksum_crc32"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.1.checksum_sha256", ""),
+					resource.TestCheckResourceAttrPair(dataSourceName, "objects.1.etag", object2ResourceName, "etag"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "objects.1.key", object2ResourceName, names.AttrKey),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.1.object_size", "12"),
+				),
+			},
+		},
+	})
+}
+
+func testAccObjectsChecksumDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test1" {
+  bucket             = aws_s3_bucket.test.bucket
+  key                = "artifacts/one.txt"
+  content            = "Hello World"
+  checksum_algorithm = "SHA256"
+}
+
+resource "aws_s3_object" "test2" {
+  bucket             = aws_s3_bucket.test.bucket
+  key                = "artifacts/two.txt"
+  content            = "Hello World!"
+  checksum_algorithm = "CRC32"
+}
+
+data "aws_s3_objects_checksum" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  keys   = [aws_s3_object.test1.key, aws_s3_object.test2.key]
+}
+`, rName)
+}
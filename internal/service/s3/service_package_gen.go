@@ -75,6 +75,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_s3_objects",
 			Name:     "Objects",
 		},
+		{
+			Factory:  dataSourceObjectsChecksum,
+			TypeName: "aws_s3_objects_checksum",
+			Name:     "Objects Checksum",
+		},
 	}
 }
 
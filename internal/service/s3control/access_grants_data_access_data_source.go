@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_s3control_access_grants_data_access", name="Access Grants Data Access")
+func newAccessGrantsDataAccessDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &accessGrantsDataAccessDataSource{}
+
+	return d, nil
+}
+
+type accessGrantsDataAccessDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*accessGrantsDataAccessDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_s3control_access_grants_data_access"
+}
+
+func (d *accessGrantsDataAccessDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAccountID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"credentials": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[credentialsModel](ctx),
+				Computed:   true,
+				Sensitive:  true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[credentialsModel](ctx),
+				},
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(900, 43200),
+				},
+			},
+			"matched_grant_target": schema.StringAttribute{
+				Computed: true,
+			},
+			"permission": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Permission](),
+				Required:   true,
+			},
+			"privilege": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Privilege](),
+				Optional:   true,
+			},
+			names.AttrTarget: schema.StringAttribute{
+				Required: true,
+			},
+			"target_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.S3PrefixType](),
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (d *accessGrantsDataAccessDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data accessGrantsDataAccessDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().S3ControlClient(ctx)
+
+	if data.AccountID.ValueString() == "" {
+		data.AccountID = types.StringValue(d.Meta().AccountID)
+	}
+	input := &s3control.GetDataAccessInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.GetDataAccess(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Access Grants data access (%s)", data.Target.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type accessGrantsDataAccessDataSourceModel struct {
+	AccountID          types.String                                      `tfsdk:"account_id"`
+	Credentials        fwtypes.ListNestedObjectValueOf[credentialsModel] `tfsdk:"credentials"`
+	DurationSeconds    types.Int64                                       `tfsdk:"duration_seconds"`
+	MatchedGrantTarget types.String                                      `tfsdk:"matched_grant_target"`
+	Permission         fwtypes.StringEnum[awstypes.Permission]           `tfsdk:"permission"`
+	Privilege          fwtypes.StringEnum[awstypes.Privilege]            `tfsdk:"privilege"`
+	Target             types.String                                      `tfsdk:"target"`
+	TargetType         fwtypes.StringEnum[awstypes.S3PrefixType]         `tfsdk:"target_type"`
+}
+
+type credentialsModel struct {
+	AccessKeyID     types.String      `tfsdk:"access_key_id"`
+	Expiration      timetypes.RFC3339 `tfsdk:"expiration"`
+	SecretAccessKey types.String      `tfsdk:"secret_access_key"`
+	SessionToken    types.String      `tfsdk:"session_token"`
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAccessGrantsDataAccessDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3control_access_grants_data_access.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsDataAccessDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, names.AttrAccountID),
+					resource.TestCheckResourceAttr(dataSourceName, "credentials.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(dataSourceName, "credentials.0.access_key_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "credentials.0.expiration"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "credentials.0.secret_access_key"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "credentials.0.session_token"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "matched_grant_target"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccessGrantsDataAccessDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAccessGrantConfig_baseCustomLocation(rName), `
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_s3control_access_grant" "test" {
+  access_grants_location_id = aws_s3control_access_grants_location.test.access_grants_location_id
+  permission                = "READ"
+
+  grantee {
+    grantee_type       = "IAM"
+    grantee_identifier = data.aws_iam_session_context.current.issuer_arn
+  }
+}
+
+data "aws_s3control_access_grants_data_access" "test" {
+  permission = aws_s3control_access_grant.test.permission
+  target     = "s3://${aws_s3_bucket.test.bucket}/${aws_s3_object.test.key}*"
+}
+`)
+}
@@ -35,6 +35,9 @@ func TestAccS3ControlAccessGrants_serial(t *testing.T) {
 			acctest.CtBasic:      testAccAccessGrantsInstanceResourcePolicy_basic,
 			acctest.CtDisappears: testAccAccessGrantsInstanceResourcePolicy_disappears,
 		},
+		"DataAccessDataSource": {
+			acctest.CtBasic: testAccAccessGrantsDataAccessDataSource_basic,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
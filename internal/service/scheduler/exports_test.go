@@ -5,6 +5,7 @@ package scheduler
 
 // Exports for use in tests only.
 var (
-	FindScheduleByTwoPartKey = findScheduleByTwoPartKey
-	ResourceSchedule         = resourceSchedule
+	FindScheduleByTwoPartKey  = findScheduleByTwoPartKey
+	ResourceSchedule          = resourceSchedule
+	ValidUniversalTargetInput = validUniversalTargetInput
 )
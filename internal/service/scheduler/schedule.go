@@ -6,15 +6,18 @@ package scheduler
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
 	"math"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -26,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -44,6 +48,8 @@ func resourceSchedule() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceScheduleCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -131,10 +137,17 @@ func resourceSchedule() *schema.Resource {
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
 			},
 			names.AttrState: {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Default:          types.ScheduleStateEnabled,
-				ValidateDiagFunc: enum.Validate[types.ScheduleState](),
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  types.ScheduleStateEnabled,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(enum.Slice(
+					types.ScheduleStateEnabled,
+					types.ScheduleStateDisabled,
+				), false)),
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// A one-time schedule that has run is disabled by the service and can't be enabled again.
+					return old == string(scheduleStateDisabledAfterCompletion) && new == string(types.ScheduleStateEnabled)
+				},
 			},
 			names.AttrTarget: {
 				Type:     schema.TypeList,
@@ -431,6 +444,10 @@ const (
 	ResNameSchedule = "Schedule"
 )
 
+const (
+	scheduleStateDisabledAfterCompletion types.ScheduleState = "DISABLED_AFTER_COMPLETION"
+)
+
 func resourceScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
@@ -603,6 +620,11 @@ func resourceScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 	if v, ok := d.Get(names.AttrState).(string); ok && v != "" {
 		in.State = types.ScheduleState(v)
+
+		// Keep a completed one-time schedule disabled.
+		if in.State == scheduleStateDisabledAfterCompletion {
+			in.State = types.ScheduleStateDisabled
+		}
 	}
 
 	log.Printf("[DEBUG] Updating EventBridge Scheduler Schedule (%s): %#v", d.Id(), in)
@@ -647,6 +669,128 @@ func resourceScheduleDelete(ctx context.Context, d *schema.ResourceData, meta in
 	return diags
 }
 
+func resourceScheduleCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("target.0.arn") || !d.NewValueKnown("target.0.input") {
+		return nil
+	}
+
+	return validUniversalTargetInput(d.Get("target.0.arn").(string), d.Get("target.0.input").(string))
+}
+
+// universalTargetInputShape lists the top-level request members of an AWS API action invoked by a universal target.
+type universalTargetInputShape struct {
+	members  []string
+	required []string
+}
+
+// universalTargetInputShapes holds the API shapes of common universal targets, keyed by "service:apiAction".
+var universalTargetInputShapes = map[string]universalTargetInputShape{
+	"ecs:runTask": {
+		members: []string{
+			"CapacityProviderStrategy",
+			"ClientToken",
+			"Cluster",
+			"Count",
+			"EnableECSManagedTags",
+			"EnableExecuteCommand",
+			"Group",
+			"LaunchType",
+			"NetworkConfiguration",
+			"Overrides",
+			"PlacementConstraints",
+			"PlacementStrategy",
+			"PlatformVersion",
+			"PropagateTags",
+			"ReferenceId",
+			"StartedBy",
+			"Tags",
+			"TaskDefinition",
+			"VolumeConfigurations",
+		},
+		required: []string{
+			"TaskDefinition",
+		},
+	},
+	"sqs:sendMessage": {
+		members: []string{
+			"DelaySeconds",
+			"MessageAttributes",
+			"MessageBody",
+			"MessageDeduplicationId",
+			"MessageGroupId",
+			"MessageSystemAttributes",
+			"QueueUrl",
+		},
+		required: []string{
+			"MessageBody",
+			"QueueUrl",
+		},
+	},
+}
+
+// parseUniversalTargetARN returns the service and API action of a universal target ARN
+// of the form "arn:aws:scheduler:::aws-sdk:service:apiAction".
+func parseUniversalTargetARN(s string) (string, string, bool) {
+	v, err := arn.Parse(s)
+
+	if err != nil || v.Service != "scheduler" {
+		return "", "", false
+	}
+
+	parts := strings.Split(v.Resource, ":")
+
+	if len(parts) != 3 || parts[0] != "aws-sdk" {
+		return "", "", false
+	}
+
+	return parts[1], parts[2], true
+}
+
+// validUniversalTargetInput checks a universal target's input against the API shape of the invoked action.
+// Targets whose shape isn't known aren't checked.
+func validUniversalTargetInput(targetARN, input string) error {
+	service, action, ok := parseUniversalTargetARN(targetARN)
+
+	if !ok {
+		return nil
+	}
+
+	shape, ok := universalTargetInputShapes[service+":"+action]
+
+	if !ok {
+		return nil
+	}
+
+	if input == "" {
+		return fmt.Errorf("target input is required for universal target %s", targetARN)
+	}
+
+	var m map[string]interface{}
+
+	if err := json.Unmarshal([]byte(input), &m); err != nil {
+		return fmt.Errorf("target input for universal target %s must be a JSON object: %w", targetARN, err)
+	}
+
+	var errs []error
+
+	for _, k := range shape.required {
+		if _, ok := m[k]; !ok {
+			errs = append(errs, fmt.Errorf("target input for universal target %s is missing required member %q", targetARN, k))
+		}
+	}
+
+	keys := tfmaps.Keys(m)
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		if !slices.Contains(shape.members, k) {
+			errs = append(errs, fmt.Errorf("target input for universal target %s contains unknown member %q, expected one of: %s", targetARN, k, strings.Join(shape.members, ", ")))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 func findScheduleByTwoPartKey(ctx context.Context, conn *scheduler.Client, groupName, scheduleName string) (*scheduler.GetScheduleOutput, error) {
 	in := &scheduler.GetScheduleInput{
 		GroupName: aws.String(groupName),
@@ -91,6 +91,81 @@ func TestResourceScheduleIDFromARN(t *testing.T) {
 	}
 }
 
+func TestValidUniversalTargetInput(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		ARN   string
+		Input string
+		Fails bool
+	}{
+		{
+			ARN:   "arn:aws:scheduler:::aws-sdk:sqs:sendMessage", //lintignore:AWSAT005
+			Input: `{"MessageBody": "<aws.scheduler.scheduled-time>", "QueueUrl": "test"}`,
+			Fails: false,
+		},
+		{
+			ARN:   "arn:aws:scheduler:::aws-sdk:sqs:sendMessage", //lintignore:AWSAT005
+			Input: `{"MessageBody": "test"}`,
+			Fails: true,
+		},
+		{
+			ARN:   "arn:aws:scheduler:::aws-sdk:sqs:sendMessage", //lintignore:AWSAT005
+			Input: `{"MessageBody": "test", "QueueUrl": "test", "QueueName": "test"}`,
+			Fails: true,
+		},
+		{
+			ARN:   "arn:aws:scheduler:::aws-sdk:sqs:sendMessage", //lintignore:AWSAT005
+			Input: `["test"]`,
+			Fails: true,
+		},
+		{
+			ARN:   "arn:aws:scheduler:::aws-sdk:sqs:sendMessage", //lintignore:AWSAT005
+			Input: "",
+			Fails: true,
+		},
+		{
+			ARN:   "arn:aws:scheduler:::aws-sdk:ecs:runTask", //lintignore:AWSAT005
+			Input: `{"Cluster": "test", "TaskDefinition": "test:1", "Count": 1}`,
+			Fails: false,
+		},
+		{
+			ARN:   "arn:aws:scheduler:::aws-sdk:ecs:runTask", //lintignore:AWSAT005
+			Input: `{"Cluster": "test"}`,
+			Fails: true,
+		},
+		{
+			ARN:   "arn:aws:scheduler:::aws-sdk:sns:publish", //lintignore:AWSAT005
+			Input: `{"Anything": "test"}`,
+			Fails: false,
+		},
+		{
+			ARN:   "arn:aws:sqs:us-west-2:123456789012:test", //lintignore:AWSAT003,AWSAT005
+			Input: `{"Anything": "test"}`,
+			Fails: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.ARN+" "+tc.Input, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfscheduler.ValidUniversalTargetInput(tc.ARN, tc.Input)
+
+			if tc.Fails {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+			} else {
+				if err != nil {
+					t.Errorf("expected no error, got: %s", err)
+				}
+			}
+		})
+	}
+}
+
 func TestResourceScheduleParseID(t *testing.T) {
 	t.Parallel()
 
@@ -1259,6 +1334,28 @@ func TestAccSchedulerSchedule_targetInput(t *testing.T) {
 	})
 }
 
+func TestAccSchedulerSchedule_targetInputInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScheduleConfig_targetInputInvalid(name),
+				ExpectError: regexache.MustCompile(`is missing required member "QueueUrl"`),
+			},
+		},
+	})
+}
+
 func TestAccSchedulerSchedule_targetKinesisParameters(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -2395,6 +2492,32 @@ resource "aws_scheduler_schedule" "test" {
 	)
 }
 
+func testAccScheduleConfig_targetInputInvalid(name string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = "arn:${data.aws_partition.main.partition}:scheduler:::aws-sdk:sqs:sendMessage"
+    role_arn = aws_iam_role.test.arn
+
+    input = jsonencode({
+      MessageBody = "test"
+    })
+  }
+}
+`, name),
+	)
+}
+
 func testAccScheduleConfig_targetKinesisParameters(scheduleName, streamName, partitionKey string) string {
 	return acctest.ConfigCompose(
 		testAccScheduleConfig_base,
@@ -67,6 +67,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  ResourceStateMachineVersionRouting,
+			TypeName: "aws_sfn_state_machine_version_routing",
+			Name:     "State Machine Version Routing",
+		},
 	}
 }
 
@@ -5,8 +5,10 @@ package sfn
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -14,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -162,7 +165,10 @@ func ResourceStateMachine() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceStateMachineCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -355,6 +361,63 @@ func resourceStateMachineDelete(ctx context.Context, d *schema.ResourceData, met
 	return diags
 }
 
+func resourceStateMachineCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Definitions that reference resources not yet created are validated by the service on apply.
+	if (d.Id() != "" && !d.HasChange("definition")) || !d.NewValueKnown("definition") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).SFNConn(ctx)
+
+	return validateStateMachineDefinition(ctx, conn, d.Get("definition").(string), d.Get(names.AttrType).(string))
+}
+
+// validateStateMachineDefinition lints the definition, including its JSONata expressions and variables.
+// Errors fail the plan and warnings are logged.
+func validateStateMachineDefinition(ctx context.Context, conn *sfn.SFN, definition, stateMachineType string) error {
+	input := &sfn.ValidateStateMachineDefinitionInput{
+		Definition: aws.String(definition),
+		Severity:   aws.String(sfn.ValidateStateMachineDefinitionSeverityWarning),
+	}
+
+	if stateMachineType != "" {
+		input.Type = aws.String(stateMachineType)
+	}
+
+	output, err := conn.ValidateStateMachineDefinitionWithContext(ctx, input)
+
+	// Linting is best effort for callers without permission to validate.
+	if tfawserr.ErrCodeEquals(err, "AccessDeniedException") {
+		log.Printf("[WARN] Skipping Step Functions State Machine definition validation: %s", err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("validating Step Functions State Machine definition: %w", err)
+	}
+
+	var errs []string
+	for _, v := range output.Diagnostics {
+		message := fmt.Sprintf("%s (%s) at %s: %s", aws.StringValue(v.Code), aws.StringValue(v.Severity), aws.StringValue(v.Location), aws.StringValue(v.Message))
+
+		if aws.StringValue(v.Severity) == sfn.ValidateStateMachineDefinitionSeverityError {
+			errs = append(errs, message)
+		} else {
+			log.Printf("[WARN] Step Functions State Machine definition: %s", message)
+		}
+	}
+
+	if aws.StringValue(output.Result) == sfn.ValidateStateMachineDefinitionResultCodeFail {
+		if len(errs) == 0 {
+			return errors.New("invalid Step Functions State Machine definition")
+		}
+
+		return fmt.Errorf("invalid Step Functions State Machine definition:\n%s", strings.Join(errs, "\n"))
+	}
+
+	return nil
+}
+
 func FindStateMachineByARN(ctx context.Context, conn *sfn.SFN, arn string) (*sfn.DescribeStateMachineOutput, error) {
 	input := &sfn.DescribeStateMachineInput{
 		StateMachineArn: aws.String(arn),
@@ -376,6 +376,28 @@ func TestAccSFNStateMachine_tracing(t *testing.T) {
 	})
 }
 
+func TestAccSFNStateMachine_definitionValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineConfig_definition(rName, `{"StartAt": "Pass", "States": {"Pass": {"Type": "Pass", "Next": "Missing"}}}`),
+				ExpectError: regexache.MustCompile(`invalid Step Functions State Machine definition`),
+			},
+			{
+				Config:      testAccStateMachineConfig_definition(rName, `{"QueryLanguage": "JSONata", "StartAt": "Pass", "States": {"Pass": {"Type": "Pass", "Output": "{% $undefined(( %}", "End": true}}}`),
+				ExpectError: regexache.MustCompile(`invalid Step Functions State Machine definition`),
+			},
+		},
+	})
+}
+
 func TestAccSFNStateMachine_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sm sfn.DescribeStateMachineOutput
@@ -627,6 +649,16 @@ EOF
 `, rName, rMaxAttempts))
 }
 
+func testAccStateMachineConfig_definition(rName, definition string) string {
+	return acctest.ConfigCompose(testAccStateMachineConfig_base(rName), fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name       = %[1]q
+  role_arn   = aws_iam_role.for_sfn.arn
+  definition = %[2]q
+}
+`, rName, definition))
+}
+
 func testAccStateMachineConfig_nameGenerated(rName string) string {
 	return acctest.ConfigCompose(testAccStateMachineConfig_base(rName), `
 resource "aws_sfn_state_machine" "test" {
@@ -0,0 +1,410 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	deploymentPreferenceTypeAllAtOnce = "ALL_AT_ONCE"
+	deploymentPreferenceTypeCanary    = "CANARY"
+	deploymentPreferenceTypeLinear    = "LINEAR"
+)
+
+func deploymentPreferenceType_Values() []string {
+	return []string{
+		deploymentPreferenceTypeAllAtOnce,
+		deploymentPreferenceTypeCanary,
+		deploymentPreferenceTypeLinear,
+	}
+}
+
+// @SDKResource("aws_sfn_state_machine_version_routing", name="State Machine Version Routing")
+func ResourceStateMachineVersionRouting() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStateMachineVersionRoutingCreate,
+		ReadWithoutTimeout:   resourceStateMachineVersionRoutingRead,
+		UpdateWithoutTimeout: resourceStateMachineVersionRoutingUpdate,
+		DeleteWithoutTimeout: resourceStateMachineVersionRoutingDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		CustomizeDiff: resourceStateMachineVersionRoutingCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"alias_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"deployment_preference": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarms": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 100,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrInterval: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      10,
+							ValidateFunc: validation.IntBetween(1, 2100),
+						},
+						"percentage": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 99),
+						},
+						names.AttrType: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(deploymentPreferenceType_Values(), false),
+						},
+					},
+				},
+			},
+			"routing_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"state_machine_version_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrWeight: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"state_machine_version_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceStateMachineVersionRoutingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	aliasARN := d.Get("alias_arn").(string)
+
+	if err := deployStateMachineVersion(ctx, meta.(*conns.AWSClient), d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deploying SFN Alias (%s) state machine version: %s", aliasARN, err)
+	}
+
+	d.SetId(aliasARN)
+
+	return append(diags, resourceStateMachineVersionRoutingRead(ctx, d, meta)...)
+}
+
+func resourceStateMachineVersionRoutingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SFNConn(ctx)
+
+	out, err := FindAliasByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SFN State Machine Version Routing (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SFN State Machine Version Routing (%s): %s", d.Id(), err)
+	}
+
+	d.Set("alias_arn", out.StateMachineAliasArn)
+	if err := d.Set("routing_configuration", flattenAliasRoutingConfiguration(out.RoutingConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting routing_configuration: %s", err)
+	}
+	// A version is only deployed once it receives all of the alias's traffic.
+	// An interrupted deployment leaves the version unset so that it's deployed again.
+	var versionARN string
+	if v := out.RoutingConfiguration; len(v) == 1 && aws.Int64Value(v[0].Weight) == 100 {
+		versionARN = aws.StringValue(v[0].StateMachineVersionArn)
+	}
+	d.Set("state_machine_version_arn", versionARN)
+
+	return diags
+}
+
+func resourceStateMachineVersionRoutingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.HasChange("state_machine_version_arn") {
+		// Keep the previous version in state if the deployment fails or is rolled back.
+		d.Partial(true)
+
+		if err := deployStateMachineVersion(ctx, meta.(*conns.AWSClient), d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deploying SFN Alias (%s) state machine version: %s", d.Id(), err)
+		}
+
+		d.Partial(false)
+	}
+
+	return append(diags, resourceStateMachineVersionRoutingRead(ctx, d, meta)...)
+}
+
+func resourceStateMachineVersionRoutingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	log.Printf("[WARN] SFN State Machine Version Routing (%s) removed from state, the alias's routing configuration is left unchanged", d.Id())
+
+	return diags
+}
+
+func resourceStateMachineVersionRoutingCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := d.GetOk("deployment_preference"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		switch deploymentType := tfMap[names.AttrType].(string); deploymentType {
+		case deploymentPreferenceTypeCanary, deploymentPreferenceTypeLinear:
+			if tfMap["percentage"].(int) == 0 {
+				return fmt.Errorf("deployment_preference.0.percentage is required for %s deployments", deploymentType)
+			}
+		}
+	}
+
+	return nil
+}
+
+type stateMachineDeploymentPreference struct {
+	alarms         []string
+	deploymentType string
+	interval       time.Duration
+	percentage     int64
+}
+
+// deployStateMachineVersion shifts the alias's traffic to the configured state machine version.
+// Between each traffic shift the deployment's alarms are monitored and the alias's original routing is restored if any alarm fires.
+func deployStateMachineVersion(ctx context.Context, client *conns.AWSClient, d *schema.ResourceData, timeout time.Duration) error {
+	conn := client.SFNConn(ctx)
+	aliasARN := d.Get("alias_arn").(string)
+	versionARN := d.Get("state_machine_version_arn").(string)
+	preference := expandStateMachineDeploymentPreference(d.Get("deployment_preference").([]interface{}))
+
+	// Each traffic shift but the last is monitored for the full interval, so that time is added to the timeout.
+	timeout += time.Duration(len(stateMachineDeploymentWeights(preference))-1) * preference.interval
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	alias, err := FindAliasByARN(ctx, conn, aliasARN)
+
+	if err != nil {
+		return fmt.Errorf("reading SFN Alias (%s): %w", aliasARN, err)
+	}
+
+	original := alias.RoutingConfiguration
+
+	// Traffic is shifted away from the version currently receiving the most traffic.
+	var originARN string
+	var originWeight int64
+	for _, v := range original {
+		if arn, weight := aws.StringValue(v.StateMachineVersionArn), aws.Int64Value(v.Weight); arn != versionARN && weight > originWeight {
+			originARN, originWeight = arn, weight
+		}
+	}
+
+	weights := []int64{100}
+	if originARN != "" {
+		weights = stateMachineDeploymentWeights(preference)
+	}
+
+	for _, weight := range weights {
+		routing := []*sfn.RoutingConfigurationListItem{{
+			StateMachineVersionArn: aws.String(versionARN),
+			Weight:                 aws.Int64(weight),
+		}}
+		if weight < 100 {
+			routing = append(routing, &sfn.RoutingConfigurationListItem{
+				StateMachineVersionArn: aws.String(originARN),
+				Weight:                 aws.Int64(100 - weight),
+			})
+		}
+
+		log.Printf("[DEBUG] Routing %d%% of SFN Alias (%s) traffic to %s", weight, aliasARN, versionARN)
+		if err := updateAliasRoutingConfiguration(ctx, conn, aliasARN, routing); err != nil {
+			return err
+		}
+
+		if weight == 100 {
+			break
+		}
+
+		if err := monitorStateMachineDeployment(ctx, client.CloudWatchClient(ctx), preference.alarms, preference.interval); err != nil {
+			// Roll back even if the deployment has timed out.
+			if rollbackErr := updateAliasRoutingConfiguration(context.WithoutCancel(ctx), conn, aliasARN, original); rollbackErr != nil {
+				return errors.Join(err, fmt.Errorf("rolling back: %w", rollbackErr))
+			}
+
+			return fmt.Errorf("rolled back: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// stateMachineDeploymentWeights returns the successive weights of the new version's traffic.
+func stateMachineDeploymentWeights(preference *stateMachineDeploymentPreference) []int64 {
+	var weights []int64
+
+	switch preference.deploymentType {
+	case deploymentPreferenceTypeCanary:
+		weights = append(weights, preference.percentage)
+	case deploymentPreferenceTypeLinear:
+		for weight := preference.percentage; weight < 100; weight += preference.percentage {
+			weights = append(weights, weight)
+		}
+	}
+
+	return append(weights, 100)
+}
+
+// monitorStateMachineDeployment waits for the interval to pass, returning an error as soon as any of the alarms is in the ALARM state.
+func monitorStateMachineDeployment(ctx context.Context, conn *cloudwatch.Client, alarms []string, interval time.Duration) error {
+	const (
+		pollInterval = 30 * time.Second
+	)
+	deadline := time.Now().Add(interval)
+
+	for {
+		if err := checkStateMachineDeploymentAlarms(ctx, conn, alarms); err != nil {
+			return err
+		}
+
+		remaining := time.Until(deadline)
+
+		if remaining <= 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(min(remaining, pollInterval)):
+		}
+	}
+}
+
+func checkStateMachineDeploymentAlarms(ctx context.Context, conn *cloudwatch.Client, alarms []string) error {
+	if len(alarms) == 0 {
+		return nil
+	}
+
+	input := &cloudwatch.DescribeAlarmsInput{
+		AlarmNames: alarms,
+		AlarmTypes: []cloudwatchtypes.AlarmType{cloudwatchtypes.AlarmTypeCompositeAlarm, cloudwatchtypes.AlarmTypeMetricAlarm},
+	}
+	var firing []string
+
+	pages := cloudwatch.NewDescribeAlarmsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return fmt.Errorf("reading CloudWatch Alarms: %w", err)
+		}
+
+		for _, v := range page.CompositeAlarms {
+			if v.StateValue == cloudwatchtypes.StateValueAlarm {
+				firing = append(firing, aws_sdkv2.ToString(v.AlarmName))
+			}
+		}
+
+		for _, v := range page.MetricAlarms {
+			if v.StateValue == cloudwatchtypes.StateValueAlarm {
+				firing = append(firing, aws_sdkv2.ToString(v.AlarmName))
+			}
+		}
+	}
+
+	if len(firing) > 0 {
+		return fmt.Errorf("CloudWatch Alarms in %s state: %s", cloudwatchtypes.StateValueAlarm, strings.Join(firing, ", "))
+	}
+
+	return nil
+}
+
+func updateAliasRoutingConfiguration(ctx context.Context, conn *sfn.SFN, aliasARN string, routing []*sfn.RoutingConfigurationListItem) error {
+	_, err := conn.UpdateStateMachineAliasWithContext(ctx, &sfn.UpdateStateMachineAliasInput{
+		RoutingConfiguration: routing,
+		StateMachineAliasArn: aws.String(aliasARN),
+	})
+
+	if err != nil {
+		return fmt.Errorf("updating SFN Alias (%s) routing configuration: %w", aliasARN, err)
+	}
+
+	return nil
+}
+
+func expandStateMachineDeploymentPreference(tfList []interface{}) *stateMachineDeploymentPreference {
+	preference := &stateMachineDeploymentPreference{
+		deploymentType: deploymentPreferenceTypeAllAtOnce,
+	}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return preference
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["alarms"].(*schema.Set); ok && v.Len() > 0 {
+		preference.alarms = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap[names.AttrInterval].(int); ok {
+		preference.interval = time.Duration(v) * time.Minute
+	}
+
+	if v, ok := tfMap["percentage"].(int); ok {
+		preference.percentage = int64(v)
+	}
+
+	if v, ok := tfMap[names.AttrType].(string); ok && v != "" {
+		preference.deploymentType = v
+	}
+
+	return preference
+}
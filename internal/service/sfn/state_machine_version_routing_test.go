@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSFNStateMachineVersionRouting_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var alias sfn.DescribeStateMachineAliasOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sfn_state_machine_version_routing.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineVersionRoutingConfig_basic(rName, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &alias),
+					resource.TestCheckResourceAttrPair(resourceName, "alias_arn", "aws_sfn_alias.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "deployment_preference.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "routing_configuration.0.state_machine_version_arn", "aws_sfn_state_machine.test", "state_machine_version_arn"),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.0.weight", "100"),
+					resource.TestCheckResourceAttrPair(resourceName, "state_machine_version_arn", "aws_sfn_state_machine.test", "state_machine_version_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStateMachineVersionRoutingConfig_linear(rName, 20),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &alias),
+					resource.TestCheckResourceAttr(resourceName, "deployment_preference.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "deployment_preference.0.type", "LINEAR"),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "routing_configuration.0.state_machine_version_arn", "aws_sfn_state_machine.test", "state_machine_version_arn"),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.0.weight", "100"),
+					resource.TestCheckResourceAttrPair(resourceName, "state_machine_version_arn", "aws_sfn_state_machine.test", "state_machine_version_arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineVersionRouting_alarmRollback(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var alias sfn.DescribeStateMachineAliasOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sfn_state_machine_version_routing.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineVersionRoutingConfig_canary(rName, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &alias),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.#", acctest.Ct1),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccSetMetricAlarmState(ctx, t, rName, cloudwatchtypes.StateValueAlarm)
+				},
+				Config:      testAccStateMachineVersionRoutingConfig_canary(rName, 20),
+				ExpectError: regexache.MustCompile(`rolled back: CloudWatch Alarms in ALARM state: ` + rName),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineVersionRouting_percentageRequired(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineVersionRoutingConfig_noPercentage(rName, 10),
+				ExpectError: regexache.MustCompile(`deployment_preference.0.percentage is required for LINEAR deployments`),
+			},
+		},
+	})
+}
+
+func testAccSetMetricAlarmState(ctx context.Context, t *testing.T, name string, state cloudwatchtypes.StateValue) {
+	t.Helper()
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchClient(ctx)
+
+	_, err := conn.SetAlarmState(ctx, &cloudwatch.SetAlarmStateInput{
+		AlarmName:   aws.String(name),
+		StateReason: aws.String("Terraform acceptance test"),
+		StateValue:  state,
+	})
+
+	if err != nil {
+		t.Fatalf("setting CloudWatch Metric Alarm (%s) state: %s", name, err)
+	}
+}
+
+func testAccStateMachineVersionRoutingConfig_base(rName string, rMaxAttempts int) string {
+	return acctest.ConfigCompose(testAccStateMachineAliasConfig_base(rName, rMaxAttempts), fmt.Sprintf(`
+resource "aws_sfn_alias" "test" {
+  name = %[1]q
+
+  routing_configuration {
+    state_machine_version_arn = aws_sfn_state_machine.test.state_machine_version_arn
+    weight                    = 100
+  }
+
+  lifecycle {
+    ignore_changes = [routing_configuration]
+  }
+}
+`, rName))
+}
+
+func testAccStateMachineVersionRoutingConfig_basic(rName string, rMaxAttempts int) string {
+	return acctest.ConfigCompose(testAccStateMachineVersionRoutingConfig_base(rName, rMaxAttempts), `
+resource "aws_sfn_state_machine_version_routing" "test" {
+  alias_arn                 = aws_sfn_alias.test.arn
+  state_machine_version_arn = aws_sfn_state_machine.test.state_machine_version_arn
+}
+`)
+}
+
+func testAccStateMachineVersionRoutingConfig_linear(rName string, rMaxAttempts int) string {
+	return acctest.ConfigCompose(testAccStateMachineVersionRoutingConfig_base(rName, rMaxAttempts), `
+resource "aws_sfn_state_machine_version_routing" "test" {
+  alias_arn                 = aws_sfn_alias.test.arn
+  state_machine_version_arn = aws_sfn_state_machine.test.state_machine_version_arn
+
+  deployment_preference {
+    type       = "LINEAR"
+    percentage = 50
+    interval   = 1
+  }
+}
+`)
+}
+
+func testAccStateMachineVersionRoutingConfig_canary(rName string, rMaxAttempts int) string {
+	return acctest.ConfigCompose(testAccStateMachineVersionRoutingConfig_base(rName, rMaxAttempts), fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 1
+  metric_name         = "ExecutionsFailed"
+  namespace           = "AWS/States"
+  period              = 60
+  statistic           = "Sum"
+  threshold           = 1
+  treat_missing_data  = "ignore"
+
+  dimensions = {
+    StateMachineArn = aws_sfn_state_machine.test.arn
+  }
+}
+
+resource "aws_sfn_state_machine_version_routing" "test" {
+  alias_arn                 = aws_sfn_alias.test.arn
+  state_machine_version_arn = aws_sfn_state_machine.test.state_machine_version_arn
+
+  deployment_preference {
+    type       = "CANARY"
+    percentage = 10
+    interval   = 1
+    alarms     = [aws_cloudwatch_metric_alarm.test.alarm_name]
+  }
+}
+`, rName))
+}
+
+func testAccStateMachineVersionRoutingConfig_noPercentage(rName string, rMaxAttempts int) string {
+	return acctest.ConfigCompose(testAccStateMachineVersionRoutingConfig_base(rName, rMaxAttempts), `
+resource "aws_sfn_state_machine_version_routing" "test" {
+  alias_arn                 = aws_sfn_alias.test.arn
+  state_machine_version_arn = aws_sfn_state_machine.test.state_machine_version_arn
+
+  deployment_preference {
+    type = "LINEAR"
+  }
+}
+`)
+}
@@ -16,8 +16,9 @@ var (
 	FindTopicAttributesByARN                       = findTopicAttributesByARN
 	FindTopicAttributesWithValidAWSPrincipalsByARN = findTopicAttributesWithValidAWSPrincipalsByARN // nosemgrep:ci.aws-in-var-name
 
-	FIFOTopicNameSuffix                = fifoTopicNameSuffix
-	ParsePlatformApplicationResourceID = parsePlatformApplicationResourceID
-	TopicAttributeNameDeliveryPolicy   = topicAttributeNameDeliveryPolicy
-	TopicAttributeNamePolicy           = topicAttributeNamePolicy
+	FIFOTopicNameSuffix                  = fifoTopicNameSuffix
+	ParsePlatformApplicationResourceID   = parsePlatformApplicationResourceID
+	SuppressEquivalentTopicArchivePolicy = suppressEquivalentTopicArchivePolicy
+	TopicAttributeNameDeliveryPolicy     = topicAttributeNameDeliveryPolicy
+	TopicAttributeNamePolicy             = topicAttributeNamePolicy
 )
@@ -5,10 +5,12 @@ package sns
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
 	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -56,7 +58,7 @@ var (
 			Type:                  schema.TypeString,
 			Optional:              true,
 			ValidateFunc:          validation.StringIsJSON,
-			DiffSuppressFunc:      verify.SuppressEquivalentJSONWithEmptyDiffs,
+			DiffSuppressFunc:      suppressEquivalentTopicArchivePolicy,
 			DiffSuppressOnRefresh: true,
 			StateFunc: func(v interface{}) string {
 				json, _ := structure.NormalizeJsonString(v)
@@ -433,9 +435,101 @@ func resourceTopicCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, me
 		}
 	}
 
+	if archivePolicy != "" && diff.NewValueKnown("archive_policy") {
+		if err := validTopicArchivePolicy(archivePolicy); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const (
+	topicArchivePolicyMessageRetentionPeriodMin = 1
+	topicArchivePolicyMessageRetentionPeriodMax = 365
+)
+
+// validTopicArchivePolicy checks that the archive policy's message retention period is a whole number of days in the range SNS accepts.
+func validTopicArchivePolicy(policy string) error {
+	var m map[string]interface{}
+
+	if err := json.Unmarshal([]byte(policy), &m); err != nil {
+		return fmt.Errorf("unmarshalling message archive policy: %w", err)
+	}
+
+	v, ok := m["MessageRetentionPeriod"]
+	if !ok {
+		return nil
+	}
+
+	days, err := topicArchivePolicyMessageRetentionPeriod(v)
+	if err != nil {
+		return err
+	}
+
+	if days < topicArchivePolicyMessageRetentionPeriodMin || days > topicArchivePolicyMessageRetentionPeriodMax {
+		return fmt.Errorf("message archive policy MessageRetentionPeriod must be between %d and %d days, got: %d", topicArchivePolicyMessageRetentionPeriodMin, topicArchivePolicyMessageRetentionPeriodMax, days)
+	}
+
 	return nil
 }
 
+// suppressEquivalentTopicArchivePolicy compares archive policies with the message retention period read as a number,
+// as SNS returns it as a string regardless of how it was configured.
+func suppressEquivalentTopicArchivePolicy(k, old, new string, d *schema.ResourceData) bool {
+	if verify.SuppressEquivalentJSONWithEmptyDiffs(k, old, new, d) {
+		return true
+	}
+
+	ob, err := normalizeTopicArchivePolicy(old)
+	if err != nil {
+		log.Print(err)
+		return false
+	}
+
+	nb, err := normalizeTopicArchivePolicy(new)
+	if err != nil {
+		log.Print(err)
+		return false
+	}
+
+	return verify.JSONBytesEqual(ob, nb)
+}
+
+func normalizeTopicArchivePolicy(policy string) ([]byte, error) {
+	var m map[string]interface{}
+
+	if err := json.Unmarshal([]byte(policy), &m); err != nil {
+		return nil, fmt.Errorf("[WARN] Unable to unmarshal SNS Topic archive policy JSON: %s", err)
+	}
+
+	if v, ok := m["MessageRetentionPeriod"]; ok {
+		if days, err := topicArchivePolicyMessageRetentionPeriod(v); err == nil {
+			m["MessageRetentionPeriod"] = days
+		}
+	}
+
+	return json.Marshal(m)
+}
+
+func topicArchivePolicyMessageRetentionPeriod(v interface{}) (int, error) {
+	switch v := v.(type) {
+	case float64:
+		if v != float64(int(v)) {
+			return 0, fmt.Errorf("message archive policy MessageRetentionPeriod must be a whole number of days, got: %v", v)
+		}
+		return int(v), nil
+	case string:
+		days, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, fmt.Errorf("message archive policy MessageRetentionPeriod must be a whole number of days, got: %q", v)
+		}
+		return days, nil
+	default:
+		return 0, fmt.Errorf("message archive policy MessageRetentionPeriod must be a number of days, got: %v", v)
+	}
+}
+
 func putTopicAttributes(ctx context.Context, conn *sns.Client, arn string, attributes map[string]string) error {
 	for name, value := range attributes {
 		// Ignore an empty policy.
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sns
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_sns_topic_subscriptions", name="Topic Subscriptions")
+func dataSourceTopicSubscriptions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTopicSubscriptionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"subscriptions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"confirmation_was_authenticated": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"delivery_policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrEndpoint: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"filter_policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"filter_policy_scope": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrOwnerID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pending_confirmation": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						names.AttrProtocol: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"raw_message_delivery": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"redrive_policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replay_policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subscription_role_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrTopicARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceTopicSubscriptionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SNSClient(ctx)
+
+	topicARN := d.Get(names.AttrTopicARN).(string)
+	subscriptions, err := findSubscriptionsByTopicARN(ctx, conn, topicARN)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SNS Topic (%s) subscriptions: %s", topicARN, err)
+	}
+
+	tfList := make([]interface{}, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		subscriptionARN := aws.ToString(subscription.SubscriptionArn)
+		tfMap := map[string]interface{}{
+			names.AttrARN:          subscriptionARN,
+			names.AttrEndpoint:     aws.ToString(subscription.Endpoint),
+			names.AttrOwnerID:      aws.ToString(subscription.Owner),
+			"pending_confirmation": false,
+			names.AttrProtocol:     aws.ToString(subscription.Protocol),
+		}
+
+		// Subscriptions pending confirmation are listed without an ARN and have no attributes.
+		if !arn.IsARN(subscriptionARN) {
+			tfMap[names.AttrARN] = ""
+			tfMap["pending_confirmation"] = true
+			tfList = append(tfList, tfMap)
+			continue
+		}
+
+		attributes, err := findSubscriptionAttributesByARN(ctx, conn, subscriptionARN)
+
+		// The subscription may have been deleted since it was listed.
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading SNS Topic Subscription (%s): %s", subscriptionARN, err)
+		}
+
+		for k, v := range map[string]string{
+			"delivery_policy":       subscriptionAttributeNameDeliveryPolicy,
+			"filter_policy":         subscriptionAttributeNameFilterPolicy,
+			"filter_policy_scope":   subscriptionAttributeNameFilterPolicyScope,
+			"redrive_policy":        subscriptionAttributeNameRedrivePolicy,
+			"replay_policy":         subscriptionAttributeNameReplayPolicy,
+			"subscription_role_arn": subscriptionAttributeNameSubscriptionRoleARN,
+		} {
+			tfMap[k] = attributes[v]
+		}
+
+		for k, v := range map[string]string{
+			"confirmation_was_authenticated": subscriptionAttributeNameConfirmationWasAuthenticated,
+			"pending_confirmation":           subscriptionAttributeNamePendingConfirmation,
+			"raw_message_delivery":           subscriptionAttributeNameRawMessageDelivery,
+		} {
+			tfMap[k], _ = strconv.ParseBool(attributes[v])
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	d.SetId(topicARN)
+	if err := d.Set("subscriptions", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting subscriptions: %s", err)
+	}
+	d.Set(names.AttrTopicARN, topicARN)
+
+	return diags
+}
+
+func findSubscriptionsByTopicARN(ctx context.Context, conn *sns.Client, topicARN string) ([]types.Subscription, error) {
+	input := &sns.ListSubscriptionsByTopicInput{
+		TopicArn: aws.String(topicARN),
+	}
+	var output []types.Subscription
+
+	pages := sns.NewListSubscriptionsByTopicPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.NotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Subscriptions...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sns_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSNSTopicSubscriptionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_sns_topic_subscriptions.test"
+	resourceName := "aws_sns_topic_subscription.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicSubscriptionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrTopicARN, "aws_sns_topic.test", names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "subscriptions.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subscriptions.0.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subscriptions.0.endpoint", resourceName, names.AttrEndpoint),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subscriptions.0.filter_policy", resourceName, "filter_policy"),
+					resource.TestCheckResourceAttr(dataSourceName, "subscriptions.0.filter_policy_scope", "MessageAttributes"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subscriptions.0.owner_id", resourceName, names.AttrOwnerID),
+					resource.TestCheckResourceAttr(dataSourceName, "subscriptions.0.pending_confirmation", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "subscriptions.0.protocol", "sqs"),
+					resource.TestCheckResourceAttr(dataSourceName, "subscriptions.0.raw_message_delivery", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func testAccTopicSubscriptionsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  sqs_managed_sse_enabled = true
+}
+
+resource "aws_sns_topic_subscription" "test" {
+  topic_arn            = aws_sns_topic.test.arn
+  protocol             = "sqs"
+  endpoint             = aws_sqs_queue.test.arn
+  raw_message_delivery = true
+
+  filter_policy = jsonencode({
+    key = ["value"]
+  })
+}
+
+data "aws_sns_topic_subscriptions" "test" {
+  topic_arn = aws_sns_topic.test.arn
+
+  depends_on = [aws_sns_topic_subscription.test]
+}
+`, rName)
+}
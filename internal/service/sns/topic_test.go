@@ -35,6 +35,54 @@ func testAccErrorCheckSkip(t *testing.T) resource.ErrorCheckFunc {
 	)
 }
 
+func TestSuppressEquivalentTopicArchivePolicy(t *testing.T) {
+	t.Parallel()
+
+	var testCases = []struct {
+		old        string
+		new        string
+		equivalent bool
+	}{
+		{
+			old:        `{"MessageRetentionPeriod":"30"}`,
+			new:        `{"MessageRetentionPeriod": 30}`,
+			equivalent: true,
+		},
+		{
+			old:        `{"MessageRetentionPeriod":"30"}`,
+			new:        `{"MessageRetentionPeriod": "30"}`,
+			equivalent: true,
+		},
+		{
+			old:        `{"MessageRetentionPeriod":"30"}`,
+			new:        `{"MessageRetentionPeriod": 45}`,
+			equivalent: false,
+		},
+		{
+			old:        `{"MessageRetentionPeriod":"45"}`,
+			new:        `{"MessageRetentionPeriod": "30"}`,
+			equivalent: false,
+		},
+		{
+			old:        ``,
+			new:        `{}`,
+			equivalent: true,
+		},
+		{
+			old:        `{"MessageRetentionPeriod":"30"}`,
+			new:        `{}`,
+			equivalent: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		actual := tfsns.SuppressEquivalentTopicArchivePolicy("", tc.old, tc.new, nil)
+		if actual != tc.equivalent {
+			t.Fatalf("Test Case %d: Got: %t Expected: %t", i, actual, tc.equivalent)
+		}
+	}
+}
+
 func TestAccSNSTopic_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var attributes map[string]string
@@ -513,6 +561,11 @@ func TestAccSNSTopic_fifoWithArchivePolicy(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "beginning_archive_time"),
 				),
 			},
+			// SNS returns the retention period as a string.
+			{
+				Config:   testAccTopicConfig_fifoArchivePolicy(rName, `{"MessageRetentionPeriod": 45}`),
+				PlanOnly: true,
+			},
 			// "Invalid state: Cannot delete a topic with an ArchivePolicy".
 			{
 				Config: testAccTopicConfig_fifoArchivePolicy(rName, "{}"),
@@ -526,6 +579,28 @@ func TestAccSNSTopic_fifoWithArchivePolicy(t *testing.T) {
 	})
 }
 
+func TestAccSNSTopic_fifoArchivePolicyInvalidRetentionPeriod(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTopicConfig_fifoArchivePolicy(rName, `{"MessageRetentionPeriod": "366"}`),
+				ExpectError: regexache.MustCompile(`MessageRetentionPeriod must be between 1 and 365 days`),
+			},
+			{
+				Config:      testAccTopicConfig_fifoArchivePolicy(rName, `{"MessageRetentionPeriod": "thirty"}`),
+				ExpectError: regexache.MustCompile(`MessageRetentionPeriod must be a whole number of days`),
+			},
+		},
+	})
+}
+
 func TestAccSNSTopic_fifoExpectArchivePolicyError(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
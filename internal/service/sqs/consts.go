@@ -51,3 +51,11 @@ const (
 	errCodeQueueDeletedRecently  = "AWS.SimpleQueueService.QueueDeletedRecently"
 	errCodeInvalidAttributeValue = "InvalidAttributeValue"
 )
+
+const (
+	messageMoveTaskStatusCancelled  = "CANCELLED"
+	messageMoveTaskStatusCancelling = "CANCELLING"
+	messageMoveTaskStatusCompleted  = "COMPLETED"
+	messageMoveTaskStatusFailed     = "FAILED"
+	messageMoveTaskStatusRunning    = "RUNNING"
+)
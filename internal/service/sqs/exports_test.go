@@ -7,10 +7,15 @@ package sqs
 var (
 	ResourceQueue                   = resourceQueue
 	ResourceQueuePolicy             = resourceQueuePolicy
+	ResourceQueuePolicyStatement    = resourceQueuePolicyStatement
 	ResourceQueueRedriveAllowPolicy = resourceQueueRedriveAllowPolicy
 	ResourceQueueRedrivePolicy      = resourceQueueRedrivePolicy
+	ResourceQueueRedriveTask        = resourceQueueRedriveTask
 
-	FindQueueAttributesByURL = findQueueAttributesByURL
+	FindMessageMoveTaskByThreePartKey    = findMessageMoveTaskByThreePartKey
+	FindQueueAttributesByURL             = findQueueAttributesByURL
+	FindQueuePolicyStatementByTwoPartKey = findQueuePolicyStatementByTwoPartKey
+	QueuePolicyStatementsEquivalent      = queuePolicyStatementsEquivalent
 
 	DefaultQueueDelaySeconds                  = defaultQueueDelaySeconds
 	DefaultQueueKMSDataKeyReusePeriodSeconds  = defaultQueueKMSDataKeyReusePeriodSeconds
@@ -0,0 +1,421 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_sqs_queue_policy_statement", name="Queue Policy Statement")
+func resourceQueuePolicyStatement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceQueuePolicyStatementCreate,
+		ReadWithoutTimeout:   resourceQueuePolicyStatementRead,
+		UpdateWithoutTimeout: resourceQueuePolicyStatementUpdate,
+		DeleteWithoutTimeout: resourceQueuePolicyStatementDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"queue_url": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"statement": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      suppressEquivalentQueuePolicyStatementDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					s, _ := structure.NormalizeJsonString(v)
+					return s
+				},
+			},
+		},
+	}
+}
+
+const (
+	queuePolicyStatementResourceIDPartCount = 2
+)
+
+func resourceQueuePolicyStatementCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SQSClient(ctx)
+
+	url, sid := d.Get("queue_url").(string), d.Get("sid").(string)
+	id, err := flex.FlattenResourceId([]string{url, sid}, queuePolicyStatementResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	statement, err := expandQueuePolicyStatement(d.Get("statement").(string), sid)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "statement (%s) is invalid JSON: %s", d.Get("statement").(string), err)
+	}
+
+	if err := putQueuePolicyStatement(ctx, conn, url, statement, true); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SQS Queue Policy Statement (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceQueuePolicyStatementRead(ctx, d, meta)...)
+}
+
+func resourceQueuePolicyStatementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SQSClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), queuePolicyStatementResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	url, sid := parts[0], parts[1]
+	outputRaw, err := tfresource.RetryWhenNotFound(ctx, queueAttributeReadTimeout, func() (interface{}, error) {
+		return findQueuePolicyStatementByTwoPartKey(ctx, conn, url, sid)
+	})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SQS Queue Policy Statement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SQS Queue Policy Statement (%s): %s", d.Id(), err)
+	}
+
+	statement, err := flattenQueuePolicyStatement(outputRaw.(map[string]interface{}))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if old := d.Get("statement").(string); old != "" && queuePolicyStatementsEquivalent(old, statement, sid) {
+		statement = old
+	}
+
+	d.Set("queue_url", url)
+	d.Set("sid", sid)
+	d.Set("statement", statement)
+
+	return diags
+}
+
+func resourceQueuePolicyStatementUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SQSClient(ctx)
+
+	url, sid := d.Get("queue_url").(string), d.Get("sid").(string)
+	statement, err := expandQueuePolicyStatement(d.Get("statement").(string), sid)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "statement (%s) is invalid JSON: %s", d.Get("statement").(string), err)
+	}
+
+	if err := putQueuePolicyStatement(ctx, conn, url, statement, false); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating SQS Queue Policy Statement (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceQueuePolicyStatementRead(ctx, d, meta)...)
+}
+
+func resourceQueuePolicyStatementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SQSClient(ctx)
+
+	url, sid := d.Get("queue_url").(string), d.Get("sid").(string)
+	conns.GlobalMutexKV.Lock(url)
+	defer conns.GlobalMutexKV.Unlock(url)
+
+	policy, err := findQueuePolicyDocumentByURL(ctx, conn, url)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SQS Queue (%s) policy: %s", url, err)
+	}
+
+	if !policy.removeStatement(sid) {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting SQS Queue Policy Statement: %s", d.Id())
+	err = setQueuePolicyDocument(ctx, conn, url, policy)
+
+	if tfawserr.ErrCodeEquals(err, errCodeQueueDoesNotExist) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SQS Queue Policy Statement (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// putQueuePolicyStatement adds or replaces a statement in a queue's policy, leaving the policy's other statements untouched.
+// SQS has no conditional writes, so the read-modify-write is only serialized within this provider.
+func putQueuePolicyStatement(ctx context.Context, conn *sqs.Client, url string, statement map[string]interface{}, create bool) error {
+	conns.GlobalMutexKV.Lock(url)
+	defer conns.GlobalMutexKV.Unlock(url)
+
+	policy, err := findQueuePolicyDocumentByURL(ctx, conn, url)
+
+	switch {
+	case tfresource.NotFound(err):
+		policy = &queuePolicyDocument{
+			Version: "2012-10-17",
+		}
+	case err != nil:
+		return fmt.Errorf("reading SQS Queue (%s) policy: %w", url, err)
+	}
+
+	sid := statement["Sid"].(string)
+	if create && policy.findStatement(sid) != nil {
+		return fmt.Errorf("statement %q already exists in SQS Queue (%s) policy", sid, url)
+	}
+
+	policy.putStatement(statement)
+
+	return setQueuePolicyDocument(ctx, conn, url, policy)
+}
+
+func setQueuePolicyDocument(ctx context.Context, conn *sqs.Client, url string, policy *queuePolicyDocument) error {
+	var attrValue string
+
+	if len(policy.Statements) > 0 {
+		v, err := json.Marshal(policy)
+		if err != nil {
+			return err
+		}
+
+		attrValue = string(v)
+	}
+
+	attributes := map[types.QueueAttributeName]string{
+		types.QueueAttributeNamePolicy: attrValue,
+	}
+	input := &sqs.SetQueueAttributesInput{
+		Attributes: flex.ExpandStringyValueMap(attributes),
+		QueueUrl:   aws.String(url),
+	}
+
+	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.SetQueueAttributes(ctx, input)
+	}, errCodeInvalidAttributeValue, "Invalid value for the parameter Policy")
+
+	if err != nil {
+		return err
+	}
+
+	if err := waitQueueAttributesPropagated(ctx, conn, url, attributes); err != nil {
+		return fmt.Errorf("waiting for SQS Queue (%s) policy update: %w", url, err)
+	}
+
+	return nil
+}
+
+func findQueuePolicyDocumentByURL(ctx context.Context, conn *sqs.Client, url string) (*queuePolicyDocument, error) {
+	output, err := findQueueAttributeByTwoPartKey(ctx, conn, url, types.QueueAttributeNamePolicy)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var policy queuePolicyDocument
+	if err := json.Unmarshal([]byte(aws.ToString(output)), &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+func findQueuePolicyStatementByTwoPartKey(ctx context.Context, conn *sqs.Client, url, sid string) (map[string]interface{}, error) {
+	policy, err := findQueuePolicyDocumentByURL(ctx, conn, url)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if statement := policy.findStatement(sid); statement != nil {
+		return statement, nil
+	}
+
+	return nil, &retry.NotFoundError{}
+}
+
+// queuePolicyDocument is a queue policy whose statements are kept as raw JSON objects so that
+// statements managed elsewhere round-trip unchanged.
+type queuePolicyDocument struct {
+	Version    string                   `json:",omitempty"`
+	ID         string                   `json:"Id,omitempty"`
+	Statements []map[string]interface{} `json:"Statement"`
+}
+
+func (p *queuePolicyDocument) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		Version   string
+		ID        string `json:"Id"`
+		Statement json.RawMessage
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	p.Version = raw.Version
+	p.ID = raw.ID
+	p.Statements = nil
+
+	v := bytes.TrimSpace(raw.Statement)
+	if len(v) == 0 {
+		return nil
+	}
+
+	// A single statement may be written as an object rather than a list.
+	if v[0] == '{' {
+		var statement map[string]interface{}
+		if err := json.Unmarshal(v, &statement); err != nil {
+			return err
+		}
+		p.Statements = append(p.Statements, statement)
+
+		return nil
+	}
+
+	return json.Unmarshal(v, &p.Statements)
+}
+
+func (p *queuePolicyDocument) findStatement(sid string) map[string]interface{} {
+	for _, statement := range p.Statements {
+		if v, ok := statement["Sid"].(string); ok && v == sid {
+			return statement
+		}
+	}
+
+	return nil
+}
+
+func (p *queuePolicyDocument) putStatement(statement map[string]interface{}) {
+	for i, v := range p.Statements {
+		if v["Sid"] == statement["Sid"] {
+			p.Statements[i] = statement
+			return
+		}
+	}
+
+	p.Statements = append(p.Statements, statement)
+}
+
+func (p *queuePolicyDocument) removeStatement(sid string) bool {
+	for i, v := range p.Statements {
+		if v["Sid"] == sid {
+			p.Statements = append(p.Statements[:i], p.Statements[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// expandQueuePolicyStatement parses a statement and sets its Sid, overriding any Sid in the JSON.
+func expandQueuePolicyStatement(s, sid string) (map[string]interface{}, error) {
+	var statement map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &statement); err != nil {
+		return nil, err
+	}
+
+	if statement == nil {
+		return nil, fmt.Errorf("statement must be a JSON object")
+	}
+
+	statement["Sid"] = sid
+
+	return statement, nil
+}
+
+// flattenQueuePolicyStatement returns a statement's JSON without its Sid, which is held in its own attribute.
+func flattenQueuePolicyStatement(statement map[string]interface{}) (string, error) {
+	v := make(map[string]interface{}, len(statement))
+	for key, value := range statement {
+		if key != "Sid" {
+			v[key] = value
+		}
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func suppressEquivalentQueuePolicyStatementDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return queuePolicyStatementsEquivalent(old, new, d.Get("sid").(string))
+}
+
+// queuePolicyStatementsEquivalent compares two statements as single-statement policies so that
+// IAM equivalences (e.g. a string vs. a one-element list) are honored.
+func queuePolicyStatementsEquivalent(s1, s2, sid string) bool {
+	policy := func(s string) (string, bool) {
+		statement, err := expandQueuePolicyStatement(s, sid)
+		if err != nil {
+			return "", false
+		}
+
+		b, err := json.Marshal(&queuePolicyDocument{
+			Version:    "2012-10-17",
+			Statements: []map[string]interface{}{statement},
+		})
+		if err != nil {
+			return "", false
+		}
+
+		return string(b), true
+	}
+
+	p1, ok := policy(s1)
+	if !ok {
+		return false
+	}
+
+	p2, ok := policy(s2)
+	if !ok {
+		return false
+	}
+
+	return verify.PolicyStringsEquivalent(p1, p2)
+}
@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestQueuePolicyStatementsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		s1, s2 string
+		want   bool
+	}{
+		"identical": {
+			s1:   `{"Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"}`,
+			s2:   `{"Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"}`,
+			want: true,
+		},
+		"string vs list": {
+			s1:   `{"Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"}`,
+			s2:   `{"Effect":"Allow","Principal":"*","Action":["sqs:SendMessage"],"Resource":"*"}`,
+			want: true,
+		},
+		"sid ignored": {
+			s1:   `{"Sid":"other","Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"}`,
+			s2:   `{"Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"}`,
+			want: true,
+		},
+		"different action": {
+			s1:   `{"Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"}`,
+			s2:   `{"Effect":"Allow","Principal":"*","Action":"sqs:ReceiveMessage","Resource":"*"}`,
+			want: false,
+		},
+		"invalid JSON": {
+			s1:   `{"Effect":"Allow"`,
+			s2:   `{"Effect":"Allow"}`,
+			want: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfsqs.QueuePolicyStatementsEquivalent(testCase.s1, testCase.s2, "test"), testCase.want; got != want {
+				t.Errorf("QueuePolicyStatementsEquivalent(%s, %s) = %t, want %t", testCase.s1, testCase.s2, got, want)
+			}
+		})
+	}
+}
+
+func TestAccSQSQueuePolicyStatement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_sqs_queue_policy_statement.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueuePolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueuePolicyStatementConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueuePolicyStatementExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "queue_url", "aws_sqs_queue.test", names.AttrURL),
+					resource.TestCheckResourceAttr(resourceName, "sid", "first"),
+					resource.TestCheckResourceAttrSet(resourceName, "statement"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:   testAccQueuePolicyStatementConfig_basic(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccSQSQueuePolicyStatement_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_sqs_queue_policy_statement.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueuePolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueuePolicyStatementConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueuePolicyStatementExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsqs.ResourceQueuePolicyStatement(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSQSQueuePolicyStatement_multiple(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName1 := "aws_sqs_queue_policy_statement.test"
+	resourceName2 := "aws_sqs_queue_policy_statement.test2"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueuePolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueuePolicyStatementConfig_multiple(rName, "sqs:SendMessage"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueuePolicyStatementExists(ctx, resourceName1),
+					testAccCheckQueuePolicyStatementExists(ctx, resourceName2),
+					resource.TestCheckResourceAttr(resourceName2, "sid", "second"),
+				),
+			},
+			{
+				Config: testAccQueuePolicyStatementConfig_multiple(rName, "sqs:ReceiveMessage"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueuePolicyStatementExists(ctx, resourceName1),
+					testAccCheckQueuePolicyStatementExists(ctx, resourceName2),
+					resource.TestMatchResourceAttr(resourceName2, "statement", regexache.MustCompile(`sqs:ReceiveMessage`)),
+				),
+			},
+			{
+				Config: testAccQueuePolicyStatementConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueuePolicyStatementExists(ctx, resourceName1),
+					testAccCheckQueuePolicyStatementNotExists(ctx, "aws_sqs_queue.test", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSQSQueuePolicyStatement_duplicateSid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueuePolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccQueuePolicyStatementConfig_duplicateSid(rName),
+				ExpectError: regexache.MustCompile(`statement "first" already exists`),
+			},
+		},
+	})
+}
+
+func testAccCheckQueuePolicyStatementDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SQSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_sqs_queue_policy_statement" {
+				continue
+			}
+
+			_, err := tfsqs.FindQueuePolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes["queue_url"], rs.Primary.Attributes["sid"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SQS Queue Policy Statement %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckQueuePolicyStatementExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SQSClient(ctx)
+
+		_, err := tfsqs.FindQueuePolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes["queue_url"], rs.Primary.Attributes["sid"])
+
+		return err
+	}
+}
+
+func testAccCheckQueuePolicyStatementNotExists(ctx context.Context, n, sid string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SQSClient(ctx)
+
+		_, err := tfsqs.FindQueuePolicyStatementByTwoPartKey(ctx, conn, rs.Primary.ID, sid)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SQS Queue (%s) policy statement %s still exists", rs.Primary.ID, sid)
+	}
+}
+
+func testAccQueuePolicyStatementConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccQueuePolicyStatementConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccQueuePolicyStatementConfig_base(rName), `
+resource "aws_sqs_queue_policy_statement" "test" {
+  queue_url = aws_sqs_queue.test.url
+  sid       = "first"
+
+  statement = jsonencode({
+    Effect = "Allow"
+    Principal = {
+      AWS = data.aws_caller_identity.current.account_id
+    }
+    Action   = "sqs:SendMessage"
+    Resource = aws_sqs_queue.test.arn
+  })
+}
+`)
+}
+
+func testAccQueuePolicyStatementConfig_multiple(rName, action string) string {
+	return acctest.ConfigCompose(testAccQueuePolicyStatementConfig_basic(rName), fmt.Sprintf(`
+resource "aws_sqs_queue_policy_statement" "test2" {
+  queue_url = aws_sqs_queue.test.url
+  sid       = "second"
+
+  statement = jsonencode({
+    Effect = "Allow"
+    Principal = {
+      AWS = data.aws_caller_identity.current.account_id
+    }
+    Action   = [%[1]q]
+    Resource = aws_sqs_queue.test.arn
+  })
+}
+`, action))
+}
+
+func testAccQueuePolicyStatementConfig_duplicateSid(rName string) string {
+	return acctest.ConfigCompose(testAccQueuePolicyStatementConfig_basic(rName), `
+resource "aws_sqs_queue_policy_statement" "test2" {
+  queue_url = aws_sqs_queue.test.url
+  sid       = "first"
+
+  statement = jsonencode({
+    Effect = "Allow"
+    Principal = {
+      AWS = data.aws_caller_identity.current.account_id
+    }
+    Action   = "sqs:ReceiveMessage"
+    Resource = aws_sqs_queue.test.arn
+  })
+
+  depends_on = [aws_sqs_queue_policy_statement.test]
+}
+`)
+}
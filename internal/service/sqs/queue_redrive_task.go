@@ -0,0 +1,334 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_sqs_queue_redrive_task", name="Queue Redrive Task")
+func resourceQueueRedriveTask() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceQueueRedriveTaskCreate,
+		ReadWithoutTimeout:   resourceQueueRedriveTaskRead,
+		DeleteWithoutTimeout: resourceQueueRedriveTaskDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"approximate_number_of_messages_moved": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"approximate_number_of_messages_to_move": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"destination_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"failure_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_number_of_messages_per_second": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 500),
+			},
+			"source_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"started_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_handle": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceQueueRedriveTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SQSClient(ctx)
+
+	sourceARN := d.Get("source_arn").(string)
+	input := &sqs.StartMessageMoveTaskInput{
+		SourceArn: aws.String(sourceARN),
+	}
+
+	if v, ok := d.GetOk("destination_arn"); ok {
+		input.DestinationArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_number_of_messages_per_second"); ok {
+		input.MaxNumberOfMessagesPerSecond = aws.Int32(int32(v.(int)))
+	}
+
+	output, err := conn.StartMessageMoveTask(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting SQS Queue (%s) redrive task: %s", sourceARN, err)
+	}
+
+	d.SetId(aws.ToString(output.TaskHandle))
+
+	// The new task is listed with its handle while it is running. Its start time is recorded
+	// so that the task can still be identified once it has finished and the handle is no longer listed.
+	outputRaw, err := tfresource.RetryWhenNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		return findMessageMoveTaskByThreePartKey(ctx, conn, sourceARN, d.Id(), 0)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SQS Queue (%s) redrive task (%s): %s", sourceARN, d.Id(), err)
+	}
+
+	startedTimestamp := outputRaw.(*types.ListMessageMoveTasksResultEntry).StartedTimestamp
+	d.Set("started_timestamp", flattenMessageMoveTaskStartedTimestamp(startedTimestamp))
+
+	if _, err := waitMessageMoveTaskCompleted(ctx, conn, sourceARN, d.Id(), startedTimestamp, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SQS Queue (%s) redrive task complete: %s", sourceARN, err)
+	}
+
+	return append(diags, resourceQueueRedriveTaskRead(ctx, d, meta)...)
+}
+
+func resourceQueueRedriveTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SQSClient(ctx)
+
+	sourceARN := d.Get("source_arn").(string)
+	startedTimestamp, err := expandMessageMoveTaskStartedTimestamp(d.Get("started_timestamp").(string))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findMessageMoveTaskByThreePartKey(ctx, conn, sourceARN, d.Id(), startedTimestamp)
+
+	// Only the most recent tasks are listed, so an older task is kept in state as last read.
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SQS Queue (%s) redrive task (%s) no longer listed, keeping last known state", sourceARN, d.Id())
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SQS Queue (%s) redrive task (%s): %s", sourceARN, d.Id(), err)
+	}
+
+	d.Set("approximate_number_of_messages_moved", output.ApproximateNumberOfMessagesMoved)
+	d.Set("approximate_number_of_messages_to_move", output.ApproximateNumberOfMessagesToMove)
+	d.Set("destination_arn", output.DestinationArn)
+	d.Set("failure_reason", output.FailureReason)
+	d.Set("max_number_of_messages_per_second", output.MaxNumberOfMessagesPerSecond)
+	d.Set("source_arn", output.SourceArn)
+	d.Set("started_timestamp", flattenMessageMoveTaskStartedTimestamp(output.StartedTimestamp))
+	d.Set(names.AttrStatus, output.Status)
+	d.Set("task_handle", d.Id())
+
+	return diags
+}
+
+func resourceQueueRedriveTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SQSClient(ctx)
+
+	sourceARN := d.Get("source_arn").(string)
+	startedTimestamp, err := expandMessageMoveTaskStartedTimestamp(d.Get("started_timestamp").(string))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findMessageMoveTaskByThreePartKey(ctx, conn, sourceARN, d.Id(), startedTimestamp)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SQS Queue (%s) redrive task (%s): %s", sourceARN, d.Id(), err)
+	}
+
+	// Only a running task can be cancelled. Messages already moved are not returned to the source queue.
+	if aws.ToString(output.Status) != messageMoveTaskStatusRunning {
+		return diags
+	}
+
+	log.Printf("[INFO] Cancelling SQS Queue (%s) redrive task: %s", sourceARN, d.Id())
+	_, err = conn.CancelMessageMoveTask(ctx, &sqs.CancelMessageMoveTaskInput{
+		TaskHandle: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "cancelling SQS Queue (%s) redrive task (%s): %s", sourceARN, d.Id(), err)
+	}
+
+	if _, err := waitMessageMoveTaskCancelled(ctx, conn, sourceARN, d.Id(), startedTimestamp, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SQS Queue (%s) redrive task (%s) cancel: %s", sourceARN, d.Id(), err)
+	}
+
+	return diags
+}
+
+// findMessageMoveTaskByThreePartKey returns the task with the specified handle. A task is only listed with its handle while it is running,
+// so a task that is no longer listed with a handle is identified by its start time, if known.
+func findMessageMoveTaskByThreePartKey(ctx context.Context, conn *sqs.Client, sourceARN, taskHandle string, startedTimestamp int64) (*types.ListMessageMoveTasksResultEntry, error) {
+	input := &sqs.ListMessageMoveTasksInput{
+		MaxResults: aws.Int32(10),
+		SourceArn:  aws.String(sourceARN),
+	}
+
+	output, err := findMessageMoveTasks(ctx, conn, input, func(v *types.ListMessageMoveTasksResultEntry) bool {
+		if v.TaskHandle != nil {
+			return aws.ToString(v.TaskHandle) == taskHandle
+		}
+
+		return startedTimestamp != 0 && v.StartedTimestamp == startedTimestamp
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+// findMessageMoveTasks returns the most recent tasks for a source queue, newest first. The API returns at most 10 tasks and is not paginated.
+func findMessageMoveTasks(ctx context.Context, conn *sqs.Client, input *sqs.ListMessageMoveTasksInput, filter tfslices.Predicate[*types.ListMessageMoveTasksResultEntry]) ([]types.ListMessageMoveTasksResultEntry, error) {
+	output, err := conn.ListMessageMoveTasks(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) || tfawserr.ErrCodeEquals(err, errCodeQueueDoesNotExist) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	var results []types.ListMessageMoveTasksResultEntry
+	for _, v := range output.Results {
+		if filter(&v) {
+			results = append(results, v)
+		}
+	}
+
+	return results, nil
+}
+
+func statusMessageMoveTask(ctx context.Context, conn *sqs.Client, sourceARN, taskHandle string, startedTimestamp int64) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findMessageMoveTaskByThreePartKey(ctx, conn, sourceARN, taskHandle, startedTimestamp)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.Status), nil
+	}
+}
+
+func waitMessageMoveTaskCompleted(ctx context.Context, conn *sqs.Client, sourceARN, taskHandle string, startedTimestamp int64, timeout time.Duration) (*types.ListMessageMoveTasksResultEntry, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{messageMoveTaskStatusRunning},
+		Target:     []string{messageMoveTaskStatusCompleted},
+		Refresh:    statusMessageMoveTask(ctx, conn, sourceARN, taskHandle, startedTimestamp),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.ListMessageMoveTasksResultEntry); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitMessageMoveTaskCancelled(ctx context.Context, conn *sqs.Client, sourceARN, taskHandle string, startedTimestamp int64, timeout time.Duration) (*types.ListMessageMoveTasksResultEntry, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{messageMoveTaskStatusRunning, messageMoveTaskStatusCancelling},
+		Target:     []string{messageMoveTaskStatusCancelled, messageMoveTaskStatusCompleted, messageMoveTaskStatusFailed},
+		Refresh:    statusMessageMoveTask(ctx, conn, sourceARN, taskHandle, startedTimestamp),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.ListMessageMoveTasksResultEntry); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func flattenMessageMoveTaskStartedTimestamp(v int64) string {
+	return time.UnixMilli(v).UTC().Format(time.RFC3339Nano)
+}
+
+// expandMessageMoveTaskStartedTimestamp returns the task's start time in milliseconds since the epoch.
+func expandMessageMoveTaskStartedTimestamp(v string) (int64, error) {
+	t, err := time.Parse(time.RFC3339Nano, v)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return t.UnixMilli(), nil
+}
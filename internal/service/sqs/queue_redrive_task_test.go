@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSQSQueueRedriveTask_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.ListMessageMoveTasksResultEntry
+	resourceName := "aws_sqs_queue_redrive_task.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueRedriveTaskConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueueRedriveTaskExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "approximate_number_of_messages_moved", acctest.Ct0),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_arn", "aws_sqs_queue.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "max_number_of_messages_per_second", acctest.Ct10),
+					resource.TestCheckResourceAttrPair(resourceName, "source_arn", "aws_sqs_queue.test_dlq", names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "started_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "COMPLETED"),
+					resource.TestCheckResourceAttrSet(resourceName, "task_handle"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckQueueRedriveTaskExists(ctx context.Context, n string, v *types.ListMessageMoveTasksResultEntry) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SQSClient(ctx)
+
+		startedTimestamp, err := time.Parse(time.RFC3339Nano, rs.Primary.Attributes["started_timestamp"])
+
+		if err != nil {
+			return err
+		}
+
+		output, err := tfsqs.FindMessageMoveTaskByThreePartKey(ctx, conn, rs.Primary.Attributes["source_arn"], rs.Primary.ID, startedTimestamp.UnixMilli())
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccQueueRedriveTaskConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sqs_queue.test_dlq.arn
+    maxReceiveCount     = 4
+  })
+}
+
+resource "aws_sqs_queue" "test_dlq" {
+  name = "%[1]s-dlq"
+}
+
+resource "aws_sqs_queue_redrive_task" "test" {
+  source_arn                        = aws_sqs_queue.test_dlq.arn
+  destination_arn                   = aws_sqs_queue.test.arn
+  max_number_of_messages_per_second = 10
+}
+`, rName)
+}
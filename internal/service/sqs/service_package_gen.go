@@ -52,6 +52,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceQueuePolicy,
 			TypeName: "aws_sqs_queue_policy",
 		},
+		{
+			Factory:  resourceQueuePolicyStatement,
+			TypeName: "aws_sqs_queue_policy_statement",
+			Name:     "Queue Policy Statement",
+		},
 		{
 			Factory:  resourceQueueRedriveAllowPolicy,
 			TypeName: "aws_sqs_queue_redrive_allow_policy",
@@ -60,6 +65,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceQueueRedrivePolicy,
 			TypeName: "aws_sqs_queue_redrive_policy",
 		},
+		{
+			Factory:  resourceQueueRedriveTask,
+			TypeName: "aws_sqs_queue_redrive_task",
+			Name:     "Queue Redrive Task",
+		},
 	}
 }
 
@@ -0,0 +1,390 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin/document"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Application Authentication Method")
+func newResourceApplicationAuthenticationMethod(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceApplicationAuthenticationMethod{}, nil
+}
+
+const (
+	ResNameApplicationAuthenticationMethod = "Application Authentication Method"
+
+	applicationAuthenticationMethodIDPartCount = 2
+)
+
+type resourceApplicationAuthenticationMethod struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceApplicationAuthenticationMethod) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_ssoadmin_application_authentication_method"
+}
+
+func (r *resourceApplicationAuthenticationMethod) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"authentication_method_type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.AuthenticationMethodType](),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"authentication_method": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"iam": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.IsRequired(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"actor_policy": schema.StringAttribute{
+										CustomType: fwtypes.IAMPolicyType,
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceApplicationAuthenticationMethod) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	var plan resourceApplicationAuthenticationMethodData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := []string{
+		plan.ApplicationARN.ValueString(),
+		plan.AuthenticationMethodType.ValueString(),
+	}
+	id, err := intflex.FlattenResourceId(idParts, applicationAuthenticationMethodIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionCreating, ResNameApplicationAuthenticationMethod, plan.ApplicationARN.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(putApplicationAuthenticationMethod(ctx, conn, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceApplicationAuthenticationMethod) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	var state resourceApplicationAuthenticationMethodData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findApplicationAuthenticationMethodByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionSetting, ResNameApplicationAuthenticationMethod, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// The application ARN and authentication method type are not returned in the finder output.
+	// To allow import to set all attributes correctly, parse the ID for these values instead.
+	parts, err := intflex.ExpandResourceId(state.ID.ValueString(), applicationAuthenticationMethodIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionSetting, ResNameApplicationAuthenticationMethod, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.ApplicationARN = fwtypes.ARNValue(parts[0])
+	state.AuthenticationMethodType = types.StringValue(parts[1])
+
+	authenticationMethod, d := flattenAuthenticationMethod(ctx, out.AuthenticationMethod)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.AuthenticationMethod = authenticationMethod
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceApplicationAuthenticationMethod) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	var plan, state resourceApplicationAuthenticationMethodData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.AuthenticationMethod.Equal(state.AuthenticationMethod) {
+		resp.Diagnostics.Append(putApplicationAuthenticationMethod(ctx, conn, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceApplicationAuthenticationMethod) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	var state resourceApplicationAuthenticationMethodData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &ssoadmin.DeleteApplicationAuthenticationMethodInput{
+		ApplicationArn:           aws.String(state.ApplicationARN.ValueString()),
+		AuthenticationMethodType: awstypes.AuthenticationMethodType(state.AuthenticationMethodType.ValueString()),
+	}
+
+	_, err := conn.DeleteApplicationAuthenticationMethod(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionDeleting, ResNameApplicationAuthenticationMethod, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceApplicationAuthenticationMethod) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+}
+
+func putApplicationAuthenticationMethod(ctx context.Context, conn *ssoadmin.Client, plan *resourceApplicationAuthenticationMethodData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var tfList []authenticationMethodData
+	diags.Append(plan.AuthenticationMethod.ElementsAs(ctx, &tfList, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	authenticationMethod, d := expandAuthenticationMethod(ctx, tfList)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	in := &ssoadmin.PutApplicationAuthenticationMethodInput{
+		ApplicationArn:           aws.String(plan.ApplicationARN.ValueString()),
+		AuthenticationMethod:     authenticationMethod,
+		AuthenticationMethodType: awstypes.AuthenticationMethodType(plan.AuthenticationMethodType.ValueString()),
+	}
+
+	_, err := conn.PutApplicationAuthenticationMethod(ctx, in)
+	if err != nil {
+		diags.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionUpdating, ResNameApplicationAuthenticationMethod, plan.ApplicationARN.String(), err),
+			err.Error(),
+		)
+	}
+
+	return diags
+}
+
+func findApplicationAuthenticationMethodByID(ctx context.Context, conn *ssoadmin.Client, id string) (*ssoadmin.GetApplicationAuthenticationMethodOutput, error) {
+	parts, err := intflex.ExpandResourceId(id, applicationAuthenticationMethodIDPartCount, false)
+	if err != nil {
+		return nil, err
+	}
+
+	in := &ssoadmin.GetApplicationAuthenticationMethodInput{
+		ApplicationArn:           aws.String(parts[0]),
+		AuthenticationMethodType: awstypes.AuthenticationMethodType(parts[1]),
+	}
+
+	out, err := conn.GetApplicationAuthenticationMethod(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil || out.AuthenticationMethod == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func expandAuthenticationMethod(ctx context.Context, tfList []authenticationMethodData) (awstypes.AuthenticationMethod, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(tfList) == 0 {
+		return nil, diags
+	}
+	tfObj := tfList[0]
+
+	var iamData []iamAuthenticationMethodData
+	diags.Append(tfObj.IAM.ElementsAs(ctx, &iamData, false)...)
+	if diags.HasError() || len(iamData) == 0 {
+		return nil, diags
+	}
+
+	actorPolicy, err := tfjson.SmithyDocumentFromString(iamData[0].ActorPolicy.ValueString(), document.NewLazyDocument)
+	if err != nil {
+		diags.AddError("actor_policy", fmt.Sprintf("decoding JSON: %s", err))
+		return nil, diags
+	}
+
+	apiObject := &awstypes.AuthenticationMethodMemberIam{
+		Value: awstypes.IamAuthenticationMethod{
+			ActorPolicy: actorPolicy,
+		},
+	}
+
+	return apiObject, diags
+}
+
+func flattenAuthenticationMethod(ctx context.Context, apiObject awstypes.AuthenticationMethod) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: authenticationMethodAttrTypes}
+	iamElemType := types.ObjectType{AttrTypes: iamAuthenticationMethodAttrTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elemType), diags
+	}
+
+	obj := map[string]attr.Value{
+		"iam": types.ListNull(iamElemType),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.AuthenticationMethodMemberIam:
+		actorPolicy, err := tfjson.SmithyDocumentToString(v.Value.ActorPolicy)
+		if err != nil {
+			diags.AddError("actor_policy", fmt.Sprintf("encoding JSON: %s", err))
+			return types.ListNull(elemType), diags
+		}
+
+		iamObjVal, d := types.ObjectValue(iamAuthenticationMethodAttrTypes, map[string]attr.Value{
+			"actor_policy": fwtypes.IAMPolicyValue(actorPolicy),
+		})
+		diags.Append(d...)
+
+		iamListVal, d := types.ListValue(iamElemType, []attr.Value{iamObjVal})
+		diags.Append(d...)
+
+		obj["iam"] = iamListVal
+	default:
+		log.Println("union is nil or unknown type")
+	}
+
+	objVal, d := types.ObjectValue(authenticationMethodAttrTypes, obj)
+	diags.Append(d...)
+
+	listVal, d := types.ListValue(elemType, []attr.Value{objVal})
+	diags.Append(d...)
+
+	return listVal, diags
+}
+
+type resourceApplicationAuthenticationMethodData struct {
+	ApplicationARN           fwtypes.ARN  `tfsdk:"application_arn"`
+	AuthenticationMethod     types.List   `tfsdk:"authentication_method"`
+	AuthenticationMethodType types.String `tfsdk:"authentication_method_type"`
+	ID                       types.String `tfsdk:"id"`
+}
+
+type authenticationMethodData struct {
+	IAM types.List `tfsdk:"iam"`
+}
+
+type iamAuthenticationMethodData struct {
+	ActorPolicy fwtypes.IAMPolicy `tfsdk:"actor_policy"`
+}
+
+var authenticationMethodAttrTypes = map[string]attr.Type{
+	"iam": types.ListType{ElemType: types.ObjectType{AttrTypes: iamAuthenticationMethodAttrTypes}},
+}
+
+var iamAuthenticationMethodAttrTypes = map[string]attr.Type{
+	"actor_policy": fwtypes.IAMPolicyType,
+}
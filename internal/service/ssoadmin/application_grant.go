@@ -0,0 +1,506 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Application Grant")
+func newResourceApplicationGrant(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceApplicationGrant{}, nil
+}
+
+const (
+	ResNameApplicationGrant = "Application Grant"
+
+	applicationGrantIDPartCount = 2
+)
+
+type resourceApplicationGrant struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceApplicationGrant) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_ssoadmin_application_grant"
+}
+
+func (r *resourceApplicationGrant) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"grant_type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.GrantType](),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"grant": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"authorization_code": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("authorization_code"),
+									path.MatchRelative().AtParent().AtName("jwt_bearer"),
+									path.MatchRelative().AtParent().AtName("refresh_token"),
+									path.MatchRelative().AtParent().AtName("token_exchange"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"redirect_uris": schema.ListAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+								},
+							},
+						},
+						"jwt_bearer": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"authorized_token_issuer": schema.ListNestedBlock{
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"authorized_audiences": schema.ListAttribute{
+													ElementType: types.StringType,
+													Optional:    true,
+												},
+												"trusted_token_issuer_arn": schema.StringAttribute{
+													CustomType: fwtypes.ARNType,
+													Optional:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"refresh_token": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{},
+						},
+						"token_exchange": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceApplicationGrant) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	var plan resourceApplicationGrantData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := []string{
+		plan.ApplicationARN.ValueString(),
+		plan.GrantType.ValueString(),
+	}
+	id, err := intflex.FlattenResourceId(idParts, applicationGrantIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionCreating, ResNameApplicationGrant, plan.ApplicationARN.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(putApplicationGrant(ctx, conn, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceApplicationGrant) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	var state resourceApplicationGrantData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findApplicationGrantByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionSetting, ResNameApplicationGrant, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// The application ARN and grant type are not returned in the finder output.
+	// To allow import to set all attributes correctly, parse the ID for these values instead.
+	parts, err := intflex.ExpandResourceId(state.ID.ValueString(), applicationGrantIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionSetting, ResNameApplicationGrant, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.ApplicationARN = fwtypes.ARNValue(parts[0])
+	state.GrantType = types.StringValue(parts[1])
+
+	grant, d := flattenGrant(ctx, out.Grant)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Grant = grant
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceApplicationGrant) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	var plan, state resourceApplicationGrantData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Grant.Equal(state.Grant) {
+		resp.Diagnostics.Append(putApplicationGrant(ctx, conn, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceApplicationGrant) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	var state resourceApplicationGrantData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &ssoadmin.DeleteApplicationGrantInput{
+		ApplicationArn: aws.String(state.ApplicationARN.ValueString()),
+		GrantType:      awstypes.GrantType(state.GrantType.ValueString()),
+	}
+
+	_, err := conn.DeleteApplicationGrant(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionDeleting, ResNameApplicationGrant, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceApplicationGrant) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+}
+
+func putApplicationGrant(ctx context.Context, conn *ssoadmin.Client, plan *resourceApplicationGrantData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var tfList []grantData
+	diags.Append(plan.Grant.ElementsAs(ctx, &tfList, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	grant, d := expandGrant(ctx, tfList)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	in := &ssoadmin.PutApplicationGrantInput{
+		ApplicationArn: aws.String(plan.ApplicationARN.ValueString()),
+		Grant:          grant,
+		GrantType:      awstypes.GrantType(plan.GrantType.ValueString()),
+	}
+
+	_, err := conn.PutApplicationGrant(ctx, in)
+	if err != nil {
+		diags.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionUpdating, ResNameApplicationGrant, plan.ApplicationARN.String(), err),
+			err.Error(),
+		)
+	}
+
+	return diags
+}
+
+func findApplicationGrantByID(ctx context.Context, conn *ssoadmin.Client, id string) (*ssoadmin.GetApplicationGrantOutput, error) {
+	parts, err := intflex.ExpandResourceId(id, applicationGrantIDPartCount, false)
+	if err != nil {
+		return nil, err
+	}
+
+	in := &ssoadmin.GetApplicationGrantInput{
+		ApplicationArn: aws.String(parts[0]),
+		GrantType:      awstypes.GrantType(parts[1]),
+	}
+
+	out, err := conn.GetApplicationGrant(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil || out.Grant == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func expandGrant(ctx context.Context, tfList []grantData) (awstypes.Grant, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(tfList) == 0 {
+		return nil, diags
+	}
+	tfObj := tfList[0]
+
+	switch {
+	case !tfObj.AuthorizationCode.IsNull() && len(tfObj.AuthorizationCode.Elements()) > 0:
+		var authorizationCode []authorizationCodeGrantData
+		diags.Append(tfObj.AuthorizationCode.ElementsAs(ctx, &authorizationCode, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &awstypes.GrantMemberAuthorizationCode{
+			Value: awstypes.AuthorizationCodeGrant{
+				RedirectUris: flex.ExpandFrameworkStringValueList(ctx, authorizationCode[0].RedirectURIs),
+			},
+		}, diags
+	case !tfObj.JWTBearer.IsNull() && len(tfObj.JWTBearer.Elements()) > 0:
+		var jwtBearer []jwtBearerGrantData
+		diags.Append(tfObj.JWTBearer.ElementsAs(ctx, &jwtBearer, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var authorizedTokenIssuers []authorizedTokenIssuerData
+		diags.Append(jwtBearer[0].AuthorizedTokenIssuer.ElementsAs(ctx, &authorizedTokenIssuers, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.GrantMemberJwtBearer{}
+		for _, v := range authorizedTokenIssuers {
+			apiObject.Value.AuthorizedTokenIssuers = append(apiObject.Value.AuthorizedTokenIssuers, awstypes.AuthorizedTokenIssuer{
+				AuthorizedAudiences:   flex.ExpandFrameworkStringValueList(ctx, v.AuthorizedAudiences),
+				TrustedTokenIssuerArn: flex.StringFromFramework(ctx, v.TrustedTokenIssuerARN),
+			})
+		}
+
+		return apiObject, diags
+	case !tfObj.RefreshToken.IsNull() && len(tfObj.RefreshToken.Elements()) > 0:
+		return &awstypes.GrantMemberRefreshToken{}, diags
+	case !tfObj.TokenExchange.IsNull() && len(tfObj.TokenExchange.Elements()) > 0:
+		return &awstypes.GrantMemberTokenExchange{}, diags
+	}
+
+	return nil, diags
+}
+
+func flattenGrant(ctx context.Context, apiObject awstypes.Grant) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: grantAttrTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elemType), diags
+	}
+
+	emptyElemType := types.ObjectType{AttrTypes: map[string]attr.Type{}}
+	obj := map[string]attr.Value{
+		"authorization_code": types.ListNull(types.ObjectType{AttrTypes: authorizationCodeGrantAttrTypes}),
+		"jwt_bearer":         types.ListNull(types.ObjectType{AttrTypes: jwtBearerGrantAttrTypes}),
+		"refresh_token":      types.ListNull(emptyElemType),
+		"token_exchange":     types.ListNull(emptyElemType),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.GrantMemberAuthorizationCode:
+		objVal, d := types.ObjectValue(authorizationCodeGrantAttrTypes, map[string]attr.Value{
+			"redirect_uris": flex.FlattenFrameworkStringValueList(ctx, v.Value.RedirectUris),
+		})
+		diags.Append(d...)
+
+		listVal, d := types.ListValue(types.ObjectType{AttrTypes: authorizationCodeGrantAttrTypes}, []attr.Value{objVal})
+		diags.Append(d...)
+
+		obj["authorization_code"] = listVal
+	case *awstypes.GrantMemberJwtBearer:
+		issuerElemType := types.ObjectType{AttrTypes: authorizedTokenIssuerAttrTypes}
+		issuers := []attr.Value{}
+		for _, issuer := range v.Value.AuthorizedTokenIssuers {
+			issuerVal, d := types.ObjectValue(authorizedTokenIssuerAttrTypes, map[string]attr.Value{
+				"authorized_audiences":     flex.FlattenFrameworkStringValueList(ctx, issuer.AuthorizedAudiences),
+				"trusted_token_issuer_arn": flex.StringToFrameworkARN(ctx, issuer.TrustedTokenIssuerArn),
+			})
+			diags.Append(d...)
+			issuers = append(issuers, issuerVal)
+		}
+
+		issuersVal, d := types.ListValue(issuerElemType, issuers)
+		diags.Append(d...)
+
+		objVal, d := types.ObjectValue(jwtBearerGrantAttrTypes, map[string]attr.Value{
+			"authorized_token_issuer": issuersVal,
+		})
+		diags.Append(d...)
+
+		listVal, d := types.ListValue(types.ObjectType{AttrTypes: jwtBearerGrantAttrTypes}, []attr.Value{objVal})
+		diags.Append(d...)
+
+		obj["jwt_bearer"] = listVal
+	case *awstypes.GrantMemberRefreshToken:
+		listVal, d := types.ListValue(emptyElemType, []attr.Value{types.ObjectValueMust(map[string]attr.Type{}, map[string]attr.Value{})})
+		diags.Append(d...)
+
+		obj["refresh_token"] = listVal
+	case *awstypes.GrantMemberTokenExchange:
+		listVal, d := types.ListValue(emptyElemType, []attr.Value{types.ObjectValueMust(map[string]attr.Type{}, map[string]attr.Value{})})
+		diags.Append(d...)
+
+		obj["token_exchange"] = listVal
+	default:
+		log.Println("union is nil or unknown type")
+	}
+
+	objVal, d := types.ObjectValue(grantAttrTypes, obj)
+	diags.Append(d...)
+
+	listVal, d := types.ListValue(elemType, []attr.Value{objVal})
+	diags.Append(d...)
+
+	return listVal, diags
+}
+
+type resourceApplicationGrantData struct {
+	ApplicationARN fwtypes.ARN  `tfsdk:"application_arn"`
+	Grant          types.List   `tfsdk:"grant"`
+	GrantType      types.String `tfsdk:"grant_type"`
+	ID             types.String `tfsdk:"id"`
+}
+
+type grantData struct {
+	AuthorizationCode types.List `tfsdk:"authorization_code"`
+	JWTBearer         types.List `tfsdk:"jwt_bearer"`
+	RefreshToken      types.List `tfsdk:"refresh_token"`
+	TokenExchange     types.List `tfsdk:"token_exchange"`
+}
+
+type authorizationCodeGrantData struct {
+	RedirectURIs types.List `tfsdk:"redirect_uris"`
+}
+
+type jwtBearerGrantData struct {
+	AuthorizedTokenIssuer types.List `tfsdk:"authorized_token_issuer"`
+}
+
+type authorizedTokenIssuerData struct {
+	AuthorizedAudiences   types.List  `tfsdk:"authorized_audiences"`
+	TrustedTokenIssuerARN fwtypes.ARN `tfsdk:"trusted_token_issuer_arn"`
+}
+
+var grantAttrTypes = map[string]attr.Type{
+	"authorization_code": types.ListType{ElemType: types.ObjectType{AttrTypes: authorizationCodeGrantAttrTypes}},
+	"jwt_bearer":         types.ListType{ElemType: types.ObjectType{AttrTypes: jwtBearerGrantAttrTypes}},
+	"refresh_token":      types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{}}},
+	"token_exchange":     types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{}}},
+}
+
+var authorizationCodeGrantAttrTypes = map[string]attr.Type{
+	"redirect_uris": types.ListType{ElemType: types.StringType},
+}
+
+var jwtBearerGrantAttrTypes = map[string]attr.Type{
+	"authorized_token_issuer": types.ListType{ElemType: types.ObjectType{AttrTypes: authorizedTokenIssuerAttrTypes}},
+}
+
+var authorizedTokenIssuerAttrTypes = map[string]attr.Type{
+	"authorized_audiences":     types.ListType{ElemType: types.StringType},
+	"trusted_token_issuer_arn": fwtypes.ARNType,
+}
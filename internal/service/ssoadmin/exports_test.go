@@ -9,11 +9,15 @@ var (
 	ResourceApplicationAssignment              = newResourceApplicationAssignment
 	ResourceApplicationAssignmentConfiguration = newResourceApplicationAssignmentConfiguration
 	ResourceApplicationAccessScope             = newResourceApplicationAccessScope
+	ResourceApplicationAuthenticationMethod    = newResourceApplicationAuthenticationMethod
+	ResourceApplicationGrant                   = newResourceApplicationGrant
 	ResourceTrustedTokenIssuer                 = newResourceTrustedTokenIssuer
 
 	FindApplicationByID                        = findApplicationByID
 	FindApplicationAssignmentByID              = findApplicationAssignmentByID
 	FindApplicationAssignmentConfigurationByID = findApplicationAssignmentConfigurationByID
 	FindApplicationAccessScopeByID             = findApplicationAccessScopeByID
+	FindApplicationAuthenticationMethodByID    = findApplicationAuthenticationMethodByID
+	FindApplicationGrantByID                   = findApplicationGrantByID
 	FindTrustedTokenIssuerByARN                = findTrustedTokenIssuerByARN
 )
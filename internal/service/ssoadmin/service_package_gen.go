@@ -52,6 +52,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceApplicationAssignmentConfiguration,
 			Name:    "Application Assignment Configuration",
 		},
+		{
+			Factory: newResourceApplicationAuthenticationMethod,
+			Name:    "Application Authentication Method",
+		},
+		{
+			Factory: newResourceApplicationGrant,
+			Name:    "Application Grant",
+		},
 		{
 			Factory: newResourceTrustedTokenIssuer,
 			Name:    "Trusted Token Issuer",
@@ -4,6 +4,8 @@
 package synthetics
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
 	"fmt"
 	"log"
@@ -159,19 +161,24 @@ func ResourceCanary() *schema.Resource {
 			names.AttrS3Bucket: {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"zip_file"},
+				ConflictsWith: []string{"script", "zip_file"},
 				RequiredWith:  []string{"s3_key"},
 			},
 			"s3_key": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"zip_file"},
+				ConflictsWith: []string{"script", "zip_file"},
 				RequiredWith:  []string{names.AttrS3Bucket},
 			},
 			"s3_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"zip_file"},
+				ConflictsWith: []string{"script", "zip_file"},
+			},
+			"script": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{names.AttrS3Bucket, "s3_key", "s3_version", "zip_file"},
 			},
 			names.AttrSchedule: {
 				Type:     schema.TypeList,
@@ -264,7 +271,7 @@ func ResourceCanary() *schema.Resource {
 			"zip_file": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{names.AttrS3Bucket, "s3_key", "s3_version"},
+				ConflictsWith: []string{names.AttrS3Bucket, "s3_key", "s3_version", "script"},
 			},
 		},
 
@@ -445,7 +452,7 @@ func resourceCanaryUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			input.RuntimeVersion = aws.String(d.Get("runtime_version").(string))
 		}
 
-		if d.HasChanges("handler", "zip_file", names.AttrS3Bucket, "s3_key", "s3_version") {
+		if d.HasChanges("handler", "zip_file", names.AttrS3Bucket, "s3_key", "s3_version", "script") {
 			if code, err := expandCanaryCode(d); err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating Synthetics Canary (%s): %s", d.Id(), err)
 			} else {
@@ -576,6 +583,12 @@ func expandCanaryCode(d *schema.ResourceData) (*awstypes.CanaryCodeInput, error)
 			return nil, fmt.Errorf("unable to load %q: %w", v.(string), err)
 		}
 		codeConfig.ZipFile = file
+	} else if v, ok := d.GetOk("script"); ok {
+		file, err := canaryScriptZip(d.Get("runtime_version").(string), d.Get("handler").(string), v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("packaging script: %w", err)
+		}
+		codeConfig.ZipFile = file
 	} else {
 		codeConfig.S3Bucket = aws.String(d.Get(names.AttrS3Bucket).(string))
 		codeConfig.S3Key = aws.String(d.Get("s3_key").(string))
@@ -588,6 +601,49 @@ func expandCanaryCode(d *schema.ResourceData) (*awstypes.CanaryCodeInput, error)
 	return codeConfig, nil
 }
 
+// canaryScriptZip packages an inline canary script into a ZIP archive laid out as required by the canary's runtime.
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/CloudWatch_Synthetics_Canaries_WritingCanary.html.
+func canaryScriptZip(runtimeVersion, handler, script string) ([]byte, error) {
+	i := strings.LastIndex(handler, ".")
+	if i <= 0 {
+		return nil, fmt.Errorf("handler (%s) must be of the form <file name>.<function name>", handler)
+	}
+	fileName := handler[:i]
+
+	var path string
+	switch {
+	case strings.HasPrefix(runtimeVersion, "syn-nodejs-"):
+		path = "nodejs/node_modules/" + fileName + ".js"
+	case strings.HasPrefix(runtimeVersion, "syn-python-"):
+		path = "python/" + fileName + ".py"
+	default:
+		return nil, fmt.Errorf("unsupported runtime version (%s)", runtimeVersion)
+	}
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	// A fixed modification time keeps the archive identical for identical scripts.
+	f, err := w.CreateHeader(&zip.FileHeader{
+		Method:   zip.Deflate,
+		Modified: time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Name:     path,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := f.Write([]byte(script)); err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
 func expandCanaryArtifactConfig(l []interface{}) *awstypes.ArtifactConfigInput {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -308,6 +308,44 @@ func TestAccSyntheticsCanary_s3(t *testing.T) {
 	})
 }
 
+func TestAccSyntheticsCanary_script(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf1, conf2 awstypes.Canary
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SyntheticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCanaryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCanaryConfig_script(rName, "https://example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCanaryExists(ctx, resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "handler", "heartbeat.handler"),
+					resource.TestCheckResourceAttrSet(resourceName, "script"),
+					resource.TestCheckResourceAttrSet(resourceName, "source_location_arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"script", "start_canary", "delete_lambda"},
+			},
+			{
+				Config: testAccCanaryConfig_script(rName, "https://example.org"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCanaryExists(ctx, resourceName, &conf2),
+					resource.TestCheckResourceAttrSet(resourceName, "source_location_arn"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSyntheticsCanary_run(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf awstypes.Canary
@@ -986,6 +1024,37 @@ resource "aws_synthetics_canary" "test" {
 `, rName))
 }
 
+func testAccCanaryConfig_script(rName, url string) string {
+	return acctest.ConfigCompose(testAccCanaryConfig_base(rName), fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "heartbeat.handler"
+  runtime_version      = "syn-nodejs-puppeteer-6.1"
+  delete_lambda        = true
+
+  script = <<-EOT
+const synthetics = require('Synthetics');
+
+exports.handler = async () => {
+  const page = await synthetics.getPage();
+  const response = await page.goto(%[2]q, { waitUntil: 'domcontentloaded', timeout: 30000 });
+  if (response.status() < 200 || response.status() > 299) {
+    throw new Error('Failed to load page');
+  }
+};
+EOT
+
+  schedule {
+    expression = "rate(0 minute)"
+  }
+
+  depends_on = [aws_iam_role.test, aws_iam_role_policy.test]
+}
+`, rName, url))
+}
+
 func testAccCanaryConfig_start(rName string, state bool) string {
 	return acctest.ConfigCompose(testAccCanaryConfig_base(rName), fmt.Sprintf(`
 resource "aws_synthetics_canary" "test" {
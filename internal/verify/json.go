@@ -24,6 +24,9 @@ func SuppressEquivalentPolicyDiffs(k, old, new string, d *schema.ResourceData) b
 	return PolicyStringsEquivalent(old, new)
 }
 
+// PolicyStringsEquivalent returns `true` if two JSON strings representing IAM policies are semantically
+// equivalent, ignoring element ordering and single-element arrays vs. strings. Empty strings (`""`)
+// and empty JSON strings (`"{}"`) are treated as equivalent.
 func PolicyStringsEquivalent(s1, s2 string) bool {
 	if strings.TrimSpace(s1) == "" && strings.TrimSpace(s2) == "" {
 		return true
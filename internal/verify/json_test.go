@@ -369,6 +369,54 @@ func TestSecondJSONUnlessEquivalent(t *testing.T) {
 	}
 }
 
+func TestPolicyStringsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		policy1    string
+		policy2    string
+		equivalent bool
+	}{
+		{
+			name:       "both empty",
+			policy1:    "",
+			policy2:    "{}",
+			equivalent: true,
+		},
+		{
+			name:       "single element array and string",
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":["ec2.amazonaws.com"]},"Action":["sts:AssumeRole"]}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}}`,
+			equivalent: true,
+		},
+		{
+			name:       "principal order",
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":["ec2.amazonaws.com","lambda.amazonaws.com"]},"Action":"sts:AssumeRole"}]}`,
+			policy2:    `{"Statement":[{"Action":"sts:AssumeRole","Principal":{"Service":["lambda.amazonaws.com","ec2.amazonaws.com"]},"Effect":"Allow"}],"Version":"2012-10-17"}`,
+			equivalent: true,
+		},
+		{
+			name:       "different principal",
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"lambda.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			equivalent: false,
+		},
+		{
+			name:       "invalid JSON",
+			policy1:    `{"Version":"2012-10-17"`,
+			policy2:    `{"Version":"2012-10-17"}`,
+			equivalent: false,
+		},
+	}
+
+	for _, v := range testCases {
+		if got := PolicyStringsEquivalent(v.policy1, v.policy2); got != v.equivalent {
+			t.Errorf("for test case %s, got %t, wanted %t", v.name, got, v.equivalent)
+		}
+	}
+}
+
 func TestNormalizeJSONOrYAMLString(t *testing.T) {
 	t.Parallel()
 